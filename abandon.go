@@ -0,0 +1,17 @@
+package promise
+
+import "context"
+
+// YouWithAbandon returns a Promise and a Completion, as You, and onAbandoned, which registers fn
+// to be called, in its own goroutine, if the Context is done before Complete is called, so that
+// the producer may release resources rather than finish work nobody will receive.
+// fn is never called if Complete is called first, and is called immediately if the Promise
+// has already been abandoned. Producers using a Future directly may use Future.OnAbandoned.
+func YouWithAbandon[T any](ctx context.Context, opts ...Option) (p Promise[T], c Complete[T], onAbandoned func(fn func())) {
+	f := NewFuture[T](ctx, opts...)
+	h := watchLeak(f)
+	return f.Promise(), func(t T, err error) {
+		h.keepAlive()
+		f.Complete(t, err)
+	}, f.OnAbandoned
+}
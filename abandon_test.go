@@ -0,0 +1,45 @@
+package promise_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestYouWithAbandon ensures expected behavior of promise.YouWithAbandon
+// 1. fn is called when the Promise's Context is done before it is completed
+// 2. registering fn does not complete the Promise
+// 3. fn is not called when the Promise is completed first
+func TestYouWithAbandon(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p, _, onAbandoned := promise.YouWithAbandon[string](ctx)
+	abandoned := make(chan struct{})
+	onAbandoned(func() {
+		close(abandoned)
+	})
+	cancel()
+
+	select {
+	case <-abandoned:
+	case <-time.After(time.Second):
+		t.Errorf("expected fn to be called")
+	}
+	av, ae := p()
+	expect(t, "", av)
+	expectNotCompleted(t, ctx, ae)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	p, c, onAbandoned := promise.YouWithAbandon[string](ctx)
+	onAbandoned(func() {
+		t.Errorf("fn should not be called")
+	})
+	c("test", nil)
+	cancel()
+	av, ae = p()
+	expect(t, "test", av)
+	expect(t, nil, ae)
+	time.Sleep(10 * time.Millisecond)
+}
@@ -0,0 +1,175 @@
+package promise
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+type (
+	// FailurePolicy decides how ForEach responds to a failing call
+	FailurePolicy int
+)
+
+const (
+	// FailFast stops processing at the first error, cancelling the Context passed to calls
+	// still running, and provides a nil slice and the first error
+	FailFast FailurePolicy = iota
+	// CollectAll processes every input, and if any call failed provides a nil slice
+	// and the errors of all failed calls joined with errors.Join
+	CollectAll
+	// BestEffort processes every input, and provides every result, including those of failed calls,
+	// along with the errors of all failed calls joined with errors.Join
+	BestEffort
+)
+
+// MeAll returns a Promise that will provide the results of every fn, in the same order as fns.
+// The functions are run by a pool of at most limit workers; a limit less than 1 runs every
+// function at once. ctx is passed to every fn.
+// If any fn returns an error, no further functions will be started, the Context passed to
+// those still running is cancelled, and a nil slice and the first error will be returned.
+// To choose a different FailurePolicy, use ForEach over fns with WithFailurePolicy.
+// If the Context is done first, a nil slice and an error wrapping both ErrNotCompleted
+// and ctx.Err() will be returned.
+func MeAll[T any](ctx context.Context, limit int, fns ...func(context.Context) (T, error)) Promise[[]T] {
+	return ForEach(ctx, fns, limit, func(ctx context.Context, fn func(context.Context) (T, error)) (T, error) {
+		return fn(ctx)
+	})
+}
+
+// ForEach returns a Promise that will provide the result of fn for every input, in the same order as inputs.
+// The inputs are processed by a pool of at most limit workers; a limit less than 1 processes every
+// input at once. ctx is passed to every call of fn.
+// By default, the FailFast policy applies: if any call returns an error, no further inputs will be
+// processed, the Context passed to calls still running is cancelled, and a nil slice and the first
+// error will be returned. Use WithFailurePolicy to choose another,
+// or WithoutCancelPropagation to let running calls finish.
+// Under every policy, if the Context is done first, no further inputs will be processed,
+// and a nil slice and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+// opts may be used to further configure the Promise.
+func ForEach[I, O any](ctx context.Context, inputs []I, limit int, fn func(context.Context, I) (O, error), opts ...Option) Promise[[]O] {
+	o := newOptions(opts)
+	p, c := You[[]O](ctx, opts...)
+	ctx = o.producerContext(ctx)
+
+	spawn(func() {
+		failFast := o.policy == FailFast
+		fnCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		results := make([]O, len(inputs))
+		err := workers(ctx, limit, len(inputs), failFast, func(i int) (err error) {
+			results[i], err = fn(fnCtx, inputs[i])
+			if err != nil && failFast && !o.isolate {
+				cancel()
+			}
+			return err
+		})
+		if err != nil && o.policy != BestEffort {
+			results = nil
+		}
+		c(results, err)
+	})
+
+	return p
+}
+
+// ForEachStream is ForEach, but yields the result of each input as soon as it is available,
+// in the order they finish, so that later work can start without waiting for every input.
+// The error of each call is held by its IndexedResult, and does not stop other inputs being processed.
+// Like every Stream, every iteration yields the same results.
+// If the Context is done first, no further inputs will be processed, and the results so far are
+// followed by the default value for IndexedResult and an error wrapping both ErrNotCompleted and ctx.Err().
+func ForEachStream[I, O any](ctx context.Context, inputs []I, limit int, fn func(context.Context, I) (O, error)) Stream[IndexedResult[O]] {
+	return MeStream(ctx, func(ctx context.Context, emit func(IndexedResult[O]) bool) error {
+		return workers(ctx, limit, len(inputs), false, func(i int) error {
+			o, err := fn(ctx, inputs[i])
+			emit(IndexedResult[O]{Index: i, Value: o, Err: err})
+			return nil
+		})
+	})
+}
+
+// workers calls work for every index in [0, n) using at most limit goroutines.
+// If failFast, no further work is started after an error, and the first error is returned;
+// otherwise every error is returned joined with errors.Join.
+// No further work is started once ctx is done.
+func workers(ctx context.Context, limit, n int, failFast bool, work func(int) error) error {
+	if limit < 1 || limit > n {
+		limit = n
+	}
+
+	var (
+		next    atomic.Int64
+		mu      sync.Mutex
+		errs    []error
+		stopped atomic.Bool
+		wg      sync.WaitGroup
+	)
+	fail := func(err error, stop bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		if stopped.Load() && (failFast || stop) {
+			// only the first error after stopping, or the Context error, is reported
+			return
+		}
+		errs = append(errs, err)
+		if stop {
+			stopped.Store(true)
+		}
+	}
+
+	for w := 0; w < limit; w++ {
+		wg.Add(1)
+		spawn(func() {
+			defer wg.Done()
+			for !stopped.Load() {
+				i := int(next.Add(1) - 1)
+				if i >= n {
+					return
+				}
+				if ctx.Err() != nil {
+					fail(notCompleted(ctx), true)
+					return
+				}
+				if err := work(i); err != nil {
+					fail(err, failFast)
+				}
+			}
+		})
+	}
+
+	wg.Wait()
+	if failFast && len(errs) > 0 {
+		return errs[0]
+	}
+	return errors.Join(errs...)
+}
+
+// Wait blocks until every Promise is resolved, or ctx is done, and returns their values
+// in the same order as ps, along with the errors of any that failed joined with errors.Join.
+// The value of a failed Promise is whatever it returned with its error.
+// Promises not resolved before ctx is done contribute the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err().
+func Wait[T any](ctx context.Context, ps ...Promise[T]) ([]T, error) {
+	return wait[T](ctx, ps)
+}
+
+// wait is Wait for any Awaiter type
+func wait[T any, A Awaiter[T]](ctx context.Context, as []A) ([]T, error) {
+	vals := make([]T, len(as))
+	errs := make([]error, len(as))
+
+	wg := sync.WaitGroup{}
+	for i, a := range as {
+		wg.Add(1)
+		spawn(func() {
+			defer wg.Done()
+			vals[i], errs[i] = a.Await(ctx)
+		})
+	}
+	wg.Wait()
+
+	return vals, errors.Join(errs...)
+}
@@ -0,0 +1,304 @@
+package promise_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestMeAll ensures expected behavior of promise.MeAll in the happy path
+// 1. the results are returned in the same order as the functions
+// 2. the results continue to be returned on all calls
+func TestMeAll(t *testing.T) {
+	var fns []func(context.Context) (int, error)
+	for i := 0; i < 50; i++ {
+		i := i
+		fns = append(fns, func(context.Context) (int, error) {
+			return i * 2, nil
+		})
+	}
+
+	p := promise.MeAll(context.Background(), 4, fns...)
+	for i := 0; i < 10; i++ {
+		av, ae := p()
+		expect(t, nil, ae)
+		expect(t, len(fns), len(av))
+		for j, v := range av {
+			expect(t, j*2, v)
+		}
+	}
+}
+
+// TestMeAllEmpty ensures that promise.MeAll with no functions provides an empty slice
+func TestMeAllEmpty(t *testing.T) {
+	av, ae := promise.MeAll[int](context.Background(), 4)()
+	expect(t, 0, len(av))
+	expect(t, nil, ae)
+}
+
+// TestMeAllLimit ensures that no more than limit functions run at once
+func TestMeAllLimit(t *testing.T) {
+	const limit = 3
+	var running, max int32
+	var fns []func(context.Context) (int, error)
+	for i := 0; i < 20; i++ {
+		fns = append(fns, func(context.Context) (int, error) {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&max)
+				if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return 0, nil
+		})
+	}
+
+	_, ae := promise.MeAll(context.Background(), limit, fns...)()
+	expect(t, nil, ae)
+	if m := atomic.LoadInt32(&max); m > limit {
+		t.Errorf("expected at most %d concurrent functions: got %d", limit, m)
+	}
+}
+
+// TestMeAllError ensures expected behavior of promise.MeAll when a function fails
+// 1. a nil slice and the error are returned
+// 2. no further functions are started
+func TestMeAllError(t *testing.T) {
+	err := fmt.Errorf("some error")
+	var calls int32
+	var fns []func(context.Context) (int, error)
+	for i := 0; i < 20; i++ {
+		fns = append(fns, func(context.Context) (int, error) {
+			atomic.AddInt32(&calls, 1)
+			return 0, err
+		})
+	}
+
+	av, ae := promise.MeAll(context.Background(), 1, fns...)()
+	expect(t, true, av == nil)
+	expect(t, err, ae)
+	expect(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+// TestMeAllCancelled ensures expected behavior of promise.MeAll when the context is done
+func TestMeAllCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	av, ae := promise.MeAll(ctx, 1, func(context.Context) (int, error) {
+		t.Errorf("function should not be called")
+		return 0, nil
+	})()
+	expect(t, true, av == nil)
+	expectNotCompleted(t, ctx, ae)
+}
+
+// TestForEach ensures expected behavior of promise.ForEach
+// 1. the results are returned in the same order as the inputs
+// 2. a nil slice and the first error are returned when a call fails
+func TestForEach(t *testing.T) {
+	var inputs []int
+	for i := 0; i < 50; i++ {
+		inputs = append(inputs, i)
+	}
+
+	av, ae := promise.ForEach(context.Background(), inputs, 4, func(_ context.Context, i int) (string, error) {
+		return fmt.Sprint(i * 2), nil
+	})()
+	expect(t, nil, ae)
+	expect(t, len(inputs), len(av))
+	for i, v := range av {
+		expect(t, fmt.Sprint(i*2), v)
+	}
+
+	err := fmt.Errorf("some error")
+	av, ae = promise.ForEach(context.Background(), inputs, 4, func(_ context.Context, i int) (string, error) {
+		if i == 10 {
+			return "", err
+		}
+		return "", nil
+	})()
+	expect(t, true, av == nil)
+	expect(t, err, ae)
+}
+
+// TestForEachFailurePolicy ensures expected behavior of each promise.FailurePolicy
+// 1. FailFast cancels the Context of running calls, and returns a nil slice and the first error
+// 2. CollectAll processes every input, and returns a nil slice and every error
+// 3. BestEffort processes every input, and returns every result and every error
+func TestForEachFailurePolicy(t *testing.T) {
+	errs := []error{fmt.Errorf("error 1"), fmt.Errorf("error 3")}
+	inputs := []int{0, 1, 2, 3, 4}
+	fn := func(_ context.Context, i int) (int, error) {
+		if i%2 == 1 {
+			return -1, errs[i/2]
+		}
+		return i, nil
+	}
+
+	cancelled := make(chan struct{})
+	av, ae := promise.ForEach(context.Background(), inputs, 2, func(ctx context.Context, i int) (int, error) {
+		if i == 0 {
+			<-ctx.Done()
+			close(cancelled)
+			return 0, nil
+		}
+		return fn(ctx, i)
+	}, promise.WithFailurePolicy(promise.FailFast))()
+	<-cancelled
+	expect(t, true, av == nil)
+	expect(t, errs[0], ae)
+
+	var calls atomic.Int32
+	av, ae = promise.ForEach(context.Background(), inputs, 2, func(ctx context.Context, i int) (int, error) {
+		calls.Add(1)
+		return fn(ctx, i)
+	}, promise.WithFailurePolicy(promise.CollectAll))()
+	expect(t, int32(len(inputs)), calls.Load())
+	expect(t, true, av == nil)
+	expect(t, true, errors.Is(ae, errs[0]))
+	expect(t, true, errors.Is(ae, errs[1]))
+
+	av, ae = promise.ForEach(context.Background(), inputs, 2, fn, promise.WithFailurePolicy(promise.BestEffort))()
+	expect(t, true, errors.Is(ae, errs[0]))
+	expect(t, true, errors.Is(ae, errs[1]))
+	expect(t, len(inputs), len(av))
+	for i, v := range []int{0, -1, 2, -1, 4} {
+		expect(t, v, av[i])
+	}
+
+	av, ae = promise.ForEach(context.Background(), []int{0, 2}, 2, fn, promise.WithFailurePolicy(promise.CollectAll))()
+	expect(t, nil, ae)
+	expect(t, 2, len(av))
+}
+
+// TestForEachWithoutCancelPropagation ensures that promise.WithoutCancelPropagation lets running calls finish
+// under FailFast, while still returning a nil slice and the first error
+func TestForEachWithoutCancelPropagation(t *testing.T) {
+	err := fmt.Errorf("some error")
+	ctxErr := make(chan error, 1)
+	av, ae := promise.ForEach(context.Background(), []int{0, 1}, 2, func(ctx context.Context, i int) (int, error) {
+		if i == 1 {
+			return 0, err
+		}
+		time.Sleep(20 * time.Millisecond)
+		ctxErr <- ctx.Err()
+		return i, nil
+	}, promise.WithoutCancelPropagation())()
+	expect(t, true, av == nil)
+	expect(t, err, ae)
+	expect(t, nil, <-ctxErr)
+}
+
+// TestForEachBestEffortCancelled ensures that promise.ForEach with BestEffort still returns a nil slice
+// and an error wrapping ErrNotCompleted and ctx.Err() when the context is done
+func TestForEachBestEffortCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p := promise.ForEach(ctx, []int{1, 2, 3}, 1, func(_ context.Context, i int) (int, error) {
+		if i == 2 {
+			cancel()
+		}
+		return i, nil
+	}, promise.WithFailurePolicy(promise.BestEffort))
+	av, ae := p()
+	expect(t, true, av == nil)
+	expectNotCompleted(t, ctx, ae)
+}
+
+// TestForEachStream ensures expected behavior of promise.ForEachStream
+// 1. a result is yielded for every input, with its index, in the order they finish
+// 2. errors are held by their result and do not stop other inputs
+func TestForEachStream(t *testing.T) {
+	err := fmt.Errorf("some error")
+	delays := []time.Duration{30 * time.Millisecond, 0, 15 * time.Millisecond}
+	s := promise.ForEachStream(context.Background(), delays, 0, func(_ context.Context, d time.Duration) (time.Duration, error) {
+		time.Sleep(d)
+		if d == 0 {
+			return 0, err
+		}
+		return d, nil
+	})
+
+	var order []int
+	for r, ae := range s {
+		expect(t, nil, ae)
+		order = append(order, r.Index)
+		if r.Index == 1 {
+			expect(t, err, r.Err)
+			continue
+		}
+		expect(t, nil, r.Err)
+		expect(t, delays[r.Index], r.Value)
+	}
+	expected := []int{1, 2, 0}
+	expect(t, len(expected), len(order))
+	for i := range expected {
+		expect(t, expected[i], order[i])
+	}
+}
+
+// TestForEachStreamCancelled ensures that promise.ForEachStream ends with an error wrapping ErrNotCompleted
+// and ctx.Err() when the context is done
+func TestForEachStreamCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := promise.ForEachStream(ctx, []int{1, 2, 3}, 1, func(_ context.Context, i int) (int, error) {
+		if i == 2 {
+			cancel()
+		}
+		return i, nil
+	})
+
+	var last error
+	for _, ae := range s {
+		last = ae
+	}
+	expectNotCompleted(t, ctx, last)
+}
+
+// TestWait ensures expected behavior of promise.Wait
+// 1. the values are returned in the same order as the Promises
+// 2. a nil error is returned when all Promises succeed
+// 3. the errors of all failed Promises are joined
+func TestWait(t *testing.T) {
+	av, ae := promise.Wait(context.Background(),
+		promise.After(context.Background(), 10*time.Millisecond, 1),
+		promise.Resolved(2),
+		promise.Resolved(3),
+	)
+	expect(t, fmt.Sprint([]int{1, 2, 3}), fmt.Sprint(av))
+	expect(t, nil, ae)
+
+	err1 := fmt.Errorf("some error")
+	err2 := fmt.Errorf("some other error")
+	av, ae = promise.Wait(context.Background(),
+		promise.Rejected[int](err1),
+		promise.Resolved(2),
+		promise.Rejected[int](err2),
+	)
+	expect(t, fmt.Sprint([]int{0, 2, 0}), fmt.Sprint(av))
+	expect(t, true, errors.Is(ae, err1))
+	expect(t, true, errors.Is(ae, err2))
+}
+
+// TestWaitCancelled ensures that promise.Wait returns once ctx is done
+// 1. the values of resolved Promises are returned
+// 2. the error wraps ErrNotCompleted and ctx.Err() for unresolved Promises
+func TestWaitCancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	pending, _ := promise.You[int](context.Background())
+
+	av, ae := promise.Wait(ctx, promise.Resolved(1), pending)
+	expect(t, fmt.Sprint([]int{1, 0}), fmt.Sprint(av))
+	expectNotCompleted(t, ctx, ae)
+}
@@ -0,0 +1,24 @@
+package promise
+
+import "context"
+
+// AsContext returns a Context derived from ctx that is cancelled, with the Promise's error as its cause,
+// if p resolves with an error. The Context is unaffected if p resolves successfully.
+// This ties downstream work to the fate of an upstream Promise: context.Cause reports why it was cancelled.
+// The Context is also done when ctx is done, or when cancel is called.
+// As with context.WithCancel, cancel should be called once the Context is no longer needed,
+// to release it from ctx.
+// If p was created from a Future, as by Me, You, and most combinators, no goroutine waits on it:
+// the Context is cancelled by a callback registered with the Future. Otherwise, p can only be observed
+// by calling it, so a goroutine calls p, and remains blocked on it until p returns, even once cancel is called.
+func AsContext[T any](ctx context.Context, p Promise[T]) (derived context.Context, cancel context.CancelFunc) {
+	derived, cancelCause := context.WithCancelCause(ctx)
+
+	OnComplete(p, func(_ T, err error) {
+		if err != nil {
+			cancelCause(err)
+		}
+	})
+
+	return derived, func() { cancelCause(nil) }
+}
@@ -0,0 +1,64 @@
+package promise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+	"github.com/nabowler/promise/promisetest"
+)
+
+// TestAsContext ensures expected behavior of promise.AsContext
+// 1. the Context is cancelled with the error of a failed Promise as its cause
+// 2. the Context is not cancelled by a successful Promise
+// 3. the Context is done when its parent is
+// 4. the Context is done when cancel is called
+func TestAsContext(t *testing.T) {
+	err := fmt.Errorf("some error")
+	ctx, cancelCtx := promise.AsContext(context.Background(), promise.Rejected[string](err))
+	defer cancelCtx()
+	<-ctx.Done()
+	expect(t, context.Canceled, ctx.Err())
+	expect(t, err, context.Cause(ctx))
+
+	ctx, cancelCtx = promise.AsContext(context.Background(), promise.Resolved("test"))
+	select {
+	case <-ctx.Done():
+		t.Errorf("expected the Context not to be done")
+	case <-time.After(10 * time.Millisecond):
+	}
+	cancelCtx()
+	<-ctx.Done()
+	expect(t, context.Canceled, context.Cause(ctx))
+
+	parent, cancel := context.WithCancel(context.Background())
+	pending, _ := promise.You[string](context.Background())
+	ctx, cancelCtx = promise.AsContext(parent, pending)
+	defer cancelCtx()
+	cancel()
+	<-ctx.Done()
+	expect(t, context.Canceled, context.Cause(ctx))
+}
+
+// TestAsContextNoGoroutine ensures that promise.AsContext does not start a goroutine for a Promise created
+// from a Future, so that Contexts that are cancelled do not leave goroutines blocked on the Promise
+func TestAsContextNoGoroutine(t *testing.T) {
+	s := promisetest.NewScheduler(t)
+	p, c := promise.You[string](context.Background())
+
+	for i := 0; i < 100; i++ {
+		_, cancel := promise.AsContext(context.Background(), p)
+		cancel()
+	}
+	expect(t, 0, s.Pending())
+
+	err := fmt.Errorf("some error")
+	ctx, cancel := promise.AsContext(context.Background(), p)
+	defer cancel()
+	c("", err)
+	s.RunUntilIdle()
+	<-ctx.Done()
+	expect(t, err, context.Cause(ctx))
+}
@@ -0,0 +1,146 @@
+package promise_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+	"github.com/nabowler/promise/promisetest"
+)
+
+// TestAwait ensures expected behavior of Promise.Await in the happy path
+// 1. the expected value and error are returned when the await ctx is not done
+// 2. the expected value and error continue to be returned on all calls
+func TestAwait(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			p := promise.Me(context.Background(), func() (string, error) {
+				return tc.val, tc.err
+			})
+			for i := 0; i < 10; i++ {
+				av, ae := p.Await(context.Background())
+				expect(t, tc.val, av)
+				expect(t, tc.err, ae)
+			}
+		})
+	}
+}
+
+// TestAwaitPerCall ensures that the ctx passed to Promise.Await only governs that call
+// 1. a short await ctx returns the default value of T and an error wrapping ErrNotCompleted and its ctx.Err()
+// 2. a later await with a longer ctx still receives the result
+// 3. calling the Promise directly still receives the result
+func TestAwaitPerCall(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			p, c := promise.You[string](context.Background())
+
+			short, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			av, ae := p.Await(short)
+			expect(t, "", av)
+			expectNotCompleted(t, short, ae)
+
+			c(tc.val, tc.err)
+
+			long, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			av, ae = p.Await(long)
+			expect(t, tc.val, av)
+			expect(t, tc.err, ae)
+
+			av, ae = p()
+			expect(t, tc.val, av)
+			expect(t, tc.err, ae)
+		})
+	}
+}
+
+// TestAwaitNoGoroutine ensures that Promise.Await does not start a goroutine for a Promise created from a Future,
+// so that awaits that give up do not leave goroutines blocked on the Promise
+func TestAwaitNoGoroutine(t *testing.T) {
+	s := promisetest.NewScheduler(t)
+	p, c := promise.You[string](context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for i := 0; i < 100; i++ {
+		av, ae := p.Await(ctx)
+		expect(t, "", av)
+		expectNotCompleted(t, ctx, ae)
+	}
+	expect(t, 0, s.Pending())
+
+	c("test", nil)
+	av, ae := p.Await(context.Background())
+	expect(t, "test", av)
+	expect(t, nil, ae)
+}
+
+// TestAwaitNoErrorPerCall ensures that the ctx passed to PromiseNoError.Await only governs that call
+// 1. a short await ctx returns the default value of T
+// 2. a later await with a longer ctx still receives the result
+// 3. calling the Promise directly still receives the result
+func TestAwaitNoErrorPerCall(t *testing.T) {
+	for name, testcase := range noErrorTestCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			p, c := promise.YouNoError[string](context.Background())
+
+			short, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			expect(t, "", p.Await(short))
+
+			c(tc)
+
+			long, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			expect(t, tc, p.Await(long))
+			expect(t, tc, p())
+		})
+	}
+}
+
+// TestAwaitTimeout ensures expected behavior of promise.AwaitTimeout
+// 1. the expected value and error are returned when the Promise resolves in time
+// 2. the default value of T and ErrAwaitTimeout are returned otherwise
+// 3. the Promise is unaffected by a timed out call
+func TestAwaitTimeout(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			p, c := promise.You[string](context.Background())
+
+			av, ae := promise.AwaitTimeout(p, 10*time.Millisecond)
+			expect(t, "", av)
+			expect(t, promise.ErrAwaitTimeout, ae)
+
+			c(tc.val, tc.err)
+			av, ae = promise.AwaitTimeout(p, time.Second)
+			expect(t, tc.val, av)
+			expect(t, tc.err, ae)
+		})
+	}
+}
+
+// TestAwaitTimeoutNoGoroutine ensures that promise.AwaitTimeout does not start a goroutine
+// for a Promise created from a Future
+func TestAwaitTimeoutNoGoroutine(t *testing.T) {
+	s := promisetest.NewScheduler(t)
+	p, c := promise.You[string](context.Background())
+
+	for i := 0; i < 100; i++ {
+		av, ae := promise.AwaitTimeout(p, time.Nanosecond)
+		expect(t, "", av)
+		expect(t, promise.ErrAwaitTimeout, ae)
+	}
+	expect(t, 0, s.Pending())
+
+	c("test", nil)
+	av, ae := promise.AwaitTimeout(p, time.Hour)
+	expect(t, "test", av)
+	expect(t, nil, ae)
+}
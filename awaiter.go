@@ -0,0 +1,65 @@
+package promise
+
+import "context"
+
+type (
+	// Awaiter is implemented by futures that can be waited on with a Context, such as Promise and Future.
+	// It allows third-party future implementations to interoperate with this package:
+	// ThenAwaiter and WaitAwaiters accept any Awaiter, and FromAwaiter adapts one for the other combinators.
+	Awaiter[T any] interface {
+		// Await blocks until the result is available or ctx is done, whichever happens first
+		Await(ctx context.Context) (T, error)
+	}
+)
+
+var (
+	_ Awaiter[any] = Promise[any](nil)
+	_ Awaiter[any] = (*Future[any])(nil)
+)
+
+// Await blocks until the Future is settled or ctx is done, whichever happens first, as Get.
+func (f *Future[T]) Await(ctx context.Context) (T, error) {
+	return f.Get(ctx)
+}
+
+// FromAwaiter returns a Promise that will provide the result of a, so that it can be used with
+// the combinators of this package that take a Promise.
+// A Promise or Future is returned as its Promise without waiting on it again.
+// Otherwise, a is awaited once with ctx, and if the Context is done first, the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func FromAwaiter[T any](ctx context.Context, a Awaiter[T]) Promise[T] {
+	switch a := a.(type) {
+	case Promise[T]:
+		return a
+	case *Future[T]:
+		return a.Promise()
+	}
+
+	return Me(ctx, func() (T, error) {
+		return a.Await(ctx)
+	})
+}
+
+// ThenAwaiter is Then for any Awaiter: it returns a Promise that will provide the result of fn
+// applied to the value of a, which is awaited with ctx.
+// fn is only called if a provides a nil error; otherwise the default value for U
+// and the error from a will be returned.
+// If the Context is done first, the default value for U and an error wrapping both
+// ErrNotCompleted and ctx.Err() will be returned.
+func ThenAwaiter[T, U any](ctx context.Context, a Awaiter[T], fn func(T) (U, error)) Promise[U] {
+	return Me(ctx, func() (U, error) {
+		t, err := a.Await(ctx)
+		if err != nil {
+			var u U
+			return u, err
+		}
+		return fn(t)
+	})
+}
+
+// WaitAwaiters is Wait for any Awaiters, such as a mix of Promises, Futures, and third-party futures:
+// it blocks until every Awaiter provides its result, or ctx is done, and returns their values
+// in the same order as as, along with the errors of any that failed joined with errors.Join.
+func WaitAwaiters[T any](ctx context.Context, as ...Awaiter[T]) ([]T, error) {
+	return wait[T](ctx, as)
+}
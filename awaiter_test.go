@@ -0,0 +1,118 @@
+package promise_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+type (
+	// chanFuture is a minimal third-party future
+	chanFuture[T any] struct {
+		ch  chan struct{}
+		val T
+		err error
+	}
+)
+
+func (f *chanFuture[T]) Await(ctx context.Context) (T, error) {
+	select {
+	case <-f.ch:
+		return f.val, f.err
+	case <-ctx.Done():
+		var t T
+		return t, ctx.Err()
+	}
+}
+
+// TestFromAwaiter ensures expected behavior of promise.FromAwaiter
+// 1. a third-party Awaiter is adapted into a Promise usable with combinators
+// 2. Promises and Futures are valid Awaiters
+func TestFromAwaiter(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			cf := &chanFuture[string]{ch: make(chan struct{}), val: tc.val, err: tc.err}
+			go func() {
+				time.Sleep(10 * time.Millisecond)
+				close(cf.ch)
+			}()
+
+			f := promise.NewFuture[string](context.Background())
+			f.Complete(tc.val, tc.err)
+			for _, a := range []promise.Awaiter[string]{cf, f, promise.Me(context.Background(), func() (string, error) {
+				return tc.val, tc.err
+			})} {
+				p := promise.Then(promise.FromAwaiter(context.Background(), a), func(v string) (int, error) {
+					return len(v), nil
+				})
+				for i := 0; i < 10; i++ {
+					av, ae := p()
+					if tc.err == nil {
+						expect(t, len(tc.val), av)
+					}
+					expect(t, tc.err, ae)
+				}
+			}
+		})
+	}
+}
+
+// TestFromAwaiterCancelled ensures that promise.FromAwaiter returns an error wrapping ErrNotCompleted
+// and ctx.Err() when the context is done before a third-party Awaiter
+func TestFromAwaiterCancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	p := promise.FromAwaiter[string](ctx, &chanFuture[string]{ch: make(chan struct{})})
+	av, ae := p()
+	expect(t, "", av)
+	expectNotCompleted(t, ctx, ae)
+}
+
+// TestAwaiterCombinators ensures that promise.ThenAwaiter and promise.WaitAwaiters accept any Awaiter
+// 1. ThenAwaiter applies fn to the value of a third-party future, or provides its error
+// 2. WaitAwaiters returns the values of a mix of Awaiters in order
+// 3. both return an error wrapping ErrNotCompleted and ctx.Err() when the Context is done first
+func TestAwaiterCombinators(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			cf := &chanFuture[string]{ch: make(chan struct{}), val: tc.val, err: tc.err}
+			close(cf.ch)
+
+			av, ae := promise.ThenAwaiter(context.Background(), cf, func(v string) (int, error) {
+				return len(v), nil
+			})()
+			if tc.err == nil {
+				expect(t, len(tc.val), av)
+			}
+			expect(t, tc.err, ae)
+
+			f := promise.NewFuture[string](context.Background())
+			f.Complete("future", nil)
+			vals, ae := promise.WaitAwaiters(context.Background(), cf, f, promise.Resolved("promise"))
+			expect(t, 3, len(vals))
+			expect(t, tc.val, vals[0])
+			expect(t, "future", vals[1])
+			expect(t, "promise", vals[2])
+			expect(t, true, errors.Is(ae, tc.err))
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	pending := &chanFuture[string]{ch: make(chan struct{})}
+	_, ae := promise.ThenAwaiter(ctx, pending, func(v string) (int, error) {
+		return len(v), nil
+	})()
+	expectNotCompleted(t, ctx, ae)
+	_, ae = promise.WaitAwaiters[string](ctx, promise.Me(ctx, func() (string, error) {
+		<-ctx.Done()
+		return "", ctx.Err()
+	}))
+	expectNotCompleted(t, ctx, ae)
+}
@@ -0,0 +1,98 @@
+package promise
+
+import (
+	"context"
+	"sync"
+)
+
+type (
+	// Batch creates keyed Promises that are all completed at once, such as by a single
+	// bulk fetch fulfilling many waiting callers.
+	// A Batch must be created with NewBatch.
+	Batch[K comparable, T any] struct {
+		ctx context.Context
+
+		mu        sync.Mutex
+		futures   map[K]*Future[T]
+		keys      []K
+		completed bool
+	}
+)
+
+// NewBatch returns an empty Batch.
+// If the Context is done before the Batch is completed, its Promises will return the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err().
+func NewBatch[K comparable, T any](ctx context.Context) *Batch[K, T] {
+	return &Batch[K, T]{
+		ctx:     ctx,
+		futures: make(map[K]*Future[T]),
+	}
+}
+
+// Promise returns the Promise for key, adding key to the Batch if it is not already present.
+// If the Batch has already been completed without a result for key, the Promise will return
+// the default value for T and ErrNoResult.
+func (b *Batch[K, T]) Promise(key K) Promise[T] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if f, ok := b.futures[key]; ok {
+		return f.Promise()
+	}
+	if b.completed {
+		return Rejected[T](ErrNoResult)
+	}
+
+	f := NewFuture[T](b.ctx)
+	b.futures[key] = f
+	b.keys = append(b.keys, key)
+	return f.Promise()
+}
+
+// Keys returns the keys in the Batch, in the order they were added
+func (b *Batch[K, T]) Keys() []K {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]K(nil), b.keys...)
+}
+
+// CompleteAll completes every Promise in the Batch with its Result in results.
+// Promises without a Result will return the default value for T and ErrNoResult.
+// Results for keys not in the Batch are ignored.
+// Only the first call to CompleteAll or Fail has any effect, and keys cannot be added
+// to the Batch while it is being completed.
+func (b *Batch[K, T]) CompleteAll(results map[K]Result[T]) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	for key, f := range b.futures {
+		r, ok := results[key]
+		if !ok {
+			r.Err = ErrNoResult
+		}
+		f.Complete(r.Value, r.Err)
+	}
+}
+
+// Fail completes every Promise in the Batch with the default value for T and err.
+// Only the first call to CompleteAll or Fail has any effect.
+func (b *Batch[K, T]) Fail(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.completed {
+		return
+	}
+	b.completed = true
+
+	var t T
+	for _, f := range b.futures {
+		f.Complete(t, err)
+	}
+}
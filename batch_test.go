@@ -0,0 +1,75 @@
+package promise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+// TestBatch ensures expected behavior of promise.Batch
+// 1. Promises for the same key are shared
+// 2. Keys returns each key once, in the order added
+// 3. CompleteAll completes every Promise with its Result
+// 4. Promises without a Result return ErrNoResult
+// 5. subsequent calls to CompleteAll do not change the returned values
+func TestBatch(t *testing.T) {
+	b := promise.NewBatch[string, int](context.Background())
+	a := b.Promise("a")
+	b.Promise("a")
+	bp := b.Promise("b")
+	c := b.Promise("c")
+	expect(t, fmt.Sprint([]string{"a", "b", "c"}), fmt.Sprint(b.Keys()))
+
+	err := fmt.Errorf("some error")
+	b.CompleteAll(map[string]promise.Result[int]{
+		"a": {Value: 1},
+		"b": {Err: err},
+		"z": {Value: 26},
+	})
+	b.CompleteAll(map[string]promise.Result[int]{
+		"a": {Value: 2},
+	})
+
+	av, ae := a()
+	expect(t, 1, av)
+	expect(t, nil, ae)
+	av, ae = b.Promise("a")()
+	expect(t, 1, av)
+	expect(t, nil, ae)
+	_, ae = bp()
+	expect(t, err, ae)
+	_, ae = c()
+	expect(t, promise.ErrNoResult, ae)
+	_, ae = b.Promise("d")()
+	expect(t, promise.ErrNoResult, ae)
+}
+
+// TestBatchFail ensures that Batch.Fail completes every Promise with the error
+func TestBatchFail(t *testing.T) {
+	b := promise.NewBatch[string, int](context.Background())
+	a := b.Promise("a")
+	c := b.Promise("c")
+
+	err := fmt.Errorf("some error")
+	b.Fail(err)
+	b.CompleteAll(map[string]promise.Result[int]{"a": {Value: 1}})
+
+	for _, p := range []promise.Promise[int]{a, c} {
+		av, ae := p()
+		expect(t, 0, av)
+		expect(t, err, ae)
+	}
+}
+
+// TestBatchCancelled ensures expected behavior of promise.Batch when the context is done
+func TestBatchCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	b := promise.NewBatch[string, int](ctx)
+	a := b.Promise("a")
+	cancel()
+
+	_, ae := a()
+	expectNotCompleted(t, ctx, ae)
+}
@@ -0,0 +1,40 @@
+package promise
+
+import (
+	"context"
+	"fmt"
+)
+
+type (
+	// Breaker is a circuit breaker guarding calls to an unhealthy dependency.
+	// Allow reports if a call may be made; if so, done must be called with whether it succeeded.
+	// This matches the method of gobreaker's TwoStepCircuitBreaker, so it may be used directly.
+	Breaker interface {
+		Allow() (done func(success bool), err error)
+	}
+)
+
+// MeWithBreaker returns a Promise that will provide the result of complete, guarded by breaker.
+// If breaker does not allow the call, complete is not started, and the default value for T
+// and an error wrapping both ErrCircuitOpen and the Breaker's error will be returned.
+// Otherwise, the outcome of complete is reported to breaker.
+// ctx is passed to complete. If the Context is done before complete, the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func MeWithBreaker[T any](ctx context.Context, breaker Breaker, complete func(context.Context) (T, error)) Promise[T] {
+	p, c := You[T](ctx)
+
+	spawn(func() {
+		done, err := breaker.Allow()
+		if err != nil {
+			var t T
+			c(t, fmt.Errorf("%w: %w", ErrCircuitOpen, err))
+			return
+		}
+
+		t, err := complete(ctx)
+		done(err == nil)
+		c(t, err)
+	})
+
+	return p
+}
@@ -0,0 +1,65 @@
+package promise_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+type (
+	// testBreaker opens after a single failure
+	testBreaker struct {
+		open      bool
+		successes int
+	}
+)
+
+var errTestBreakerOpen = errors.New("test breaker open")
+
+func (b *testBreaker) Allow() (func(bool), error) {
+	if b.open {
+		return nil, errTestBreakerOpen
+	}
+	return func(success bool) {
+		if success {
+			b.successes++
+		} else {
+			b.open = true
+		}
+	}, nil
+}
+
+// TestMeWithBreaker ensures expected behavior of promise.MeWithBreaker
+// 1. the result of complete is returned while the Breaker allows calls
+// 2. the outcome of complete is reported to the Breaker
+// 3. complete is not called once the Breaker is open, and an error wrapping ErrCircuitOpen
+// and the Breaker's error is returned
+func TestMeWithBreaker(t *testing.T) {
+	b := &testBreaker{}
+	err := fmt.Errorf("some error")
+
+	av, ae := promise.MeWithBreaker(context.Background(), b, func(context.Context) (string, error) {
+		return "test", nil
+	})()
+	expect(t, "test", av)
+	expect(t, nil, ae)
+	expect(t, 1, b.successes)
+
+	av, ae = promise.MeWithBreaker(context.Background(), b, func(context.Context) (string, error) {
+		return "", err
+	})()
+	expect(t, "", av)
+	expect(t, err, ae)
+	expect(t, true, b.open)
+
+	av, ae = promise.MeWithBreaker(context.Background(), b, func(context.Context) (string, error) {
+		t.Errorf("complete should not be called")
+		return "test", nil
+	})()
+	expect(t, "", av)
+	expect(t, true, errors.Is(ae, promise.ErrCircuitOpen))
+	expect(t, true, errors.Is(ae, errTestBreakerOpen))
+}
@@ -0,0 +1,109 @@
+package promise
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type (
+	cache[T any] struct {
+		ctx      context.Context
+		ttl      time.Duration
+		ahead    time.Duration
+		complete func() (T, error)
+
+		mu      sync.Mutex
+		current *cacheEntry[T]
+		refresh *cacheEntry[T]
+	}
+
+	cacheEntry[T any] struct {
+		f       *Future[T]
+		expires time.Time
+	}
+)
+
+// CachedMe returns a function that provides a Promise for the result of complete.
+// A successful result is cached for ttl after complete returns; once it expires the next call
+// will start complete again and return a Promise for the new result.
+// Errors are not cached: the call following an error will start complete again.
+// complete is not started until the first call, and is never run more than once at a time.
+// If the Context is done before complete, the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func CachedMe[T any](ctx context.Context, ttl time.Duration, complete func() (T, error)) func() Promise[T] {
+	return CachedMeRefreshAhead(ctx, ttl, 0, complete)
+}
+
+// CachedMeRefreshAhead is CachedMe, but when a call is made within ahead of the cached result expiring,
+// complete is started in the background. The cached result will continue to be provided until the
+// refreshed result is available, so callers do not block on expiry.
+// A failed refresh is discarded, and the cached result will be provided until it expires.
+func CachedMeRefreshAhead[T any](ctx context.Context, ttl, ahead time.Duration, complete func() (T, error)) func() Promise[T] {
+	c := &cache[T]{
+		ctx:      ctx,
+		ttl:      ttl,
+		ahead:    ahead,
+		complete: complete,
+	}
+	return c.get
+}
+
+func (c *cache[T]) get() Promise[T] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.refresh != nil && c.refresh.settled() {
+		if c.refresh.f.State() == StateFulfilled {
+			c.current = c.refresh
+		}
+		c.refresh = nil
+	}
+
+	if c.current == nil {
+		c.current = c.start()
+		return c.current.f.Promise()
+	}
+	if !c.current.settled() {
+		return c.current.f.Promise()
+	}
+
+	now := clock().Now()
+	if c.current.f.State() != StateFulfilled || !now.Before(c.current.expires) {
+		if c.refresh != nil {
+			c.current, c.refresh = c.refresh, nil
+		} else {
+			c.current = c.start()
+		}
+		return c.current.f.Promise()
+	}
+
+	if c.ahead > 0 && c.refresh == nil && !now.Before(c.current.expires.Add(-c.ahead)) {
+		c.refresh = c.start()
+	}
+	return c.current.f.Promise()
+}
+
+// start runs complete in the background. c.mu must be held.
+func (c *cache[T]) start() *cacheEntry[T] {
+	e := &cacheEntry[T]{f: NewFuture[T](c.ctx)}
+
+	spawn(func() {
+		t, err := c.complete()
+		c.mu.Lock()
+		e.expires = clock().Now().Add(c.ttl)
+		c.mu.Unlock()
+		e.f.Complete(t, err)
+	})
+
+	return e
+}
+
+func (e *cacheEntry[T]) settled() bool {
+	select {
+	case <-e.f.Done():
+		return true
+	default:
+		return false
+	}
+}
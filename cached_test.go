@@ -0,0 +1,110 @@
+package promise_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+	"github.com/nabowler/promise/promisetest"
+)
+
+// TestCachedMe ensures expected behavior of promise.CachedMe
+// 1. complete is not called until the first call
+// 2. the cached result is provided until ttl expires
+// 3. a new result is provided after ttl expires
+func TestCachedMe(t *testing.T) {
+	c := promisetest.NewClock(t)
+	var calls int32
+	get := promise.CachedMe(context.Background(), 50*time.Millisecond, func() (int32, error) {
+		return atomic.AddInt32(&calls, 1), nil
+	})
+
+	c.Advance(10 * time.Millisecond)
+	expect(t, int32(0), atomic.LoadInt32(&calls))
+
+	for i := 0; i < 10; i++ {
+		av, ae := get()()
+		expect(t, int32(1), av)
+		expect(t, nil, ae)
+	}
+
+	c.Advance(49 * time.Millisecond)
+	av, ae := get()()
+	expect(t, int32(1), av)
+	expect(t, nil, ae)
+	c.Advance(time.Millisecond)
+	av, ae = get()()
+	expect(t, int32(2), av)
+	expect(t, nil, ae)
+	expect(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+// TestCachedMeError ensures that promise.CachedMe does not cache errors
+func TestCachedMeError(t *testing.T) {
+	var calls int32
+	get := promise.CachedMe(context.Background(), time.Hour, func() (int32, error) {
+		n := atomic.AddInt32(&calls, 1)
+		if n == 1 {
+			return n, fmt.Errorf("some error")
+		}
+		return n, nil
+	})
+
+	_, ae := get()()
+	expect(t, "some error", ae.Error())
+	av, ae := get()()
+	expect(t, int32(2), av)
+	expect(t, nil, ae)
+	av, _ = get()()
+	expect(t, int32(2), av)
+}
+
+// TestCachedMeRefreshAhead ensures expected behavior of promise.CachedMeRefreshAhead
+// 1. a call within ahead of expiry starts a refresh but provides the cached result
+// 2. the refreshed result is provided once available
+func TestCachedMeRefreshAhead(t *testing.T) {
+	c := promisetest.NewClock(t)
+	var calls int32
+	release := make(chan struct{})
+	get := promise.CachedMeRefreshAhead(context.Background(), 100*time.Millisecond, 90*time.Millisecond, func() (int32, error) {
+		n := atomic.AddInt32(&calls, 1)
+		if n > 1 {
+			<-release
+		}
+		return n, nil
+	})
+
+	av, _ := get()()
+	expect(t, int32(1), av)
+
+	c.Advance(9 * time.Millisecond)
+	av, _ = get()()
+	expect(t, int32(1), av)
+	expect(t, int32(1), atomic.LoadInt32(&calls))
+
+	c.Advance(time.Millisecond)
+	av, _ = get()()
+	expect(t, int32(1), av)
+	close(release)
+
+	for av != 2 {
+		av, _ = get()()
+	}
+	expect(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+// TestCachedMeCancelled ensures expected behavior of promise.CachedMe when the context is done
+func TestCachedMeCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	get := promise.CachedMe(ctx, time.Hour, func() (string, error) {
+		return "test", nil
+	})
+
+	av, ae := get()()
+	expect(t, "", av)
+	expectNotCompleted(t, ctx, ae)
+}
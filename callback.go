@@ -0,0 +1,17 @@
+package promise
+
+import "context"
+
+// FromCallback returns a Promise that will provide the result passed to done, adapting callback-based
+// APIs such as SDK async handlers, cgo callbacks, or event emitters.
+// start is called on the calling goroutine to begin the operation, and done may be called from any goroutine,
+// during or after start. Only the first call to done has any effect; later calls no-op.
+// If done is never called, the Promise is only settled once the Context is done,
+// with the default value for T and an error wrapping both ErrNotCompleted and ctx.Err().
+// opts may be used to further configure the Promise, such as WithTimeout to bound how long
+// the callback is waited for.
+func FromCallback[T any](ctx context.Context, start func(done func(T, error)), opts ...Option) Promise[T] {
+	p, c := You[T](ctx, opts...)
+	start(c)
+	return p
+}
@@ -0,0 +1,51 @@
+package promise_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestFromCallback ensures expected behavior of promise.FromCallback
+// 1. the values passed to the callback are returned, whether it fires during or after start
+// 2. only the first call to the callback has any effect
+func TestFromCallback(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			immediate := promise.FromCallback(context.Background(), func(done func(string, error)) {
+				done(tc.val, tc.err)
+				done("ignored", nil)
+			})
+			async := promise.FromCallback(context.Background(), func(done func(string, error)) {
+				go func() {
+					time.Sleep(10 * time.Millisecond)
+					done(tc.val, tc.err)
+					done("ignored", nil)
+				}()
+			})
+
+			for _, p := range []promise.Promise[string]{immediate, async} {
+				for i := 0; i < 10; i++ {
+					av, ae := p()
+					expect(t, tc.val, av)
+					expect(t, tc.err, ae)
+				}
+			}
+		})
+	}
+}
+
+// TestFromCallbackNeverCalled ensures that promise.FromCallback returns an error wrapping ErrNotCompleted
+// and ctx.Err() once the context is done if the callback never fires
+func TestFromCallbackNeverCalled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	p := promise.FromCallback(ctx, func(func(string, error)) {})
+	av, ae := p()
+	expect(t, "", av)
+	expectNotCompleted(t, ctx, ae)
+}
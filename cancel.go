@@ -0,0 +1,21 @@
+package promise
+
+import "context"
+
+// MeWithCancel returns a Promise that will provide the result of complete, and a CancelFunc
+// that abandons it without cancelling ctx.
+// complete is passed a Context derived from ctx that is cancelled by the CancelFunc.
+// If either Context is done before complete, the default value for T
+// and an error wrapping both ErrNotCompleted and the Context's error will be returned.
+func MeWithCancel[T any](ctx context.Context, complete func(context.Context) (T, error)) (Promise[T], context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	p, c := You[T](ctx)
+
+	spawn(func() {
+		// release the derived Context once the result is known
+		defer cancel()
+		c(complete(ctx))
+	})
+
+	return p, cancel
+}
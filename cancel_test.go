@@ -0,0 +1,53 @@
+package promise_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+// TestMeWithCancel ensures expected behavior of promise.MeWithCancel in the happy path
+// 1. the expected value and error are returned
+// 2. calling cancel after completion does not change the returned values
+func TestMeWithCancel(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			p, cancel := promise.MeWithCancel(context.Background(), func(context.Context) (string, error) {
+				return tc.val, tc.err
+			})
+			av, ae := p()
+			expect(t, tc.val, av)
+			expect(t, tc.err, ae)
+
+			cancel()
+			av, ae = p()
+			expect(t, tc.val, av)
+			expect(t, tc.err, ae)
+		})
+	}
+}
+
+// TestMeWithCancelCancelled ensures expected behavior of promise.MeWithCancel when cancelled
+// 1. the default value of T and an error wrapping ErrNotCompleted and context.Canceled are returned
+// 2. the Context passed to complete is done
+// 3. the parent Context is not cancelled
+func TestMeWithCancelCancelled(t *testing.T) {
+	parent := context.Background()
+	done := make(chan struct{})
+	p, cancel := promise.MeWithCancel(parent, func(ctx context.Context) (string, error) {
+		<-ctx.Done()
+		close(done)
+		return "test", nil
+	})
+
+	cancel()
+	av, ae := p()
+	expect(t, "", av)
+	expect(t, true, errors.Is(ae, promise.ErrNotCompleted))
+	expect(t, true, errors.Is(ae, context.Canceled))
+	<-done
+	expect(t, nil, parent.Err())
+}
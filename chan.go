@@ -0,0 +1,31 @@
+package promise
+
+import "context"
+
+// FromChan returns a Promise that will provide the first value received from ch.
+// If ch is closed without a value, the default value for T and ErrChanClosed will be returned.
+// If the Context is done first, the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func FromChan[T any](ctx context.Context, ch <-chan T) Promise[T] {
+	p, c := You[T](ctx)
+
+	spawn(func() {
+		select {
+		case t, ok := <-ch:
+			if !ok {
+				c(t, ErrChanClosed)
+				return
+			}
+			c(t, nil)
+		case <-ctx.Done():
+		}
+	})
+
+	return p
+}
+
+// ToChan returns a channel that will receive the result of p once p is resolved.
+// It is equivalent to Subscribe, and is provided as the counterpart to FromChan.
+func ToChan[T any](p Promise[T]) <-chan Result[T] {
+	return Subscribe(p)
+}
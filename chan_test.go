@@ -0,0 +1,61 @@
+package promise_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+// TestFromChan ensures expected behavior of promise.FromChan
+// 1. the first value received is returned with a nil error
+// 2. the same value continues to be returned on all calls
+func TestFromChan(t *testing.T) {
+	ch := make(chan string, 2)
+	ch <- "first"
+	ch <- "second"
+
+	p := promise.FromChan(context.Background(), ch)
+	for i := 0; i < 10; i++ {
+		av, ae := p()
+		expect(t, "first", av)
+		expect(t, nil, ae)
+	}
+}
+
+// TestFromChanClosed ensures that promise.FromChan returns ErrChanClosed when the channel is closed without a value
+func TestFromChanClosed(t *testing.T) {
+	ch := make(chan string)
+	close(ch)
+
+	av, ae := promise.FromChan(context.Background(), ch)()
+	expect(t, "", av)
+	expect(t, promise.ErrChanClosed, ae)
+}
+
+// TestFromChanCancelled ensures expected behavior of promise.FromChan when the context is done
+func TestFromChanCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	av, ae := promise.FromChan(ctx, make(chan string))()
+	expect(t, "", av)
+	expectNotCompleted(t, ctx, ae)
+}
+
+// TestToChan ensures that promise.ToChan delivers the result of the Promise and closes the channel
+func TestToChan(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			ch := promise.ToChan(promise.Me(context.Background(), func() (string, error) {
+				return tc.val, tc.err
+			}))
+			r := <-ch
+			expect(t, tc.val, r.Value)
+			expect(t, tc.err, r.Err)
+			_, ok := <-ch
+			expect(t, false, ok)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package promise
+
+import "context"
+
+// Chunked returns a Promise that will provide the results of fn for every chunk of items, concatenated
+// in the same order as items, for backends that accept batched requests.
+// items is split into consecutive chunks of at most chunkSize items, which are all processed at once;
+// a chunkSize less than 1 processes items as a single chunk. ctx is passed to every call of fn.
+// The FailurePolicy of ForEach applies per chunk, and opts may be used to choose another:
+// by default, if any call returns an error, the Context passed to calls still running is cancelled,
+// and a nil slice and the first error will be returned.
+// BestEffort is not supported, as the results of the other chunks could not be aligned with items
+// without those of the failed chunks, so the returned Promise is rejected with ErrUnsupportedPolicy;
+// use ForEach over the chunks for the result of each.
+// If the Context is done first, a nil slice and an error wrapping both ErrNotCompleted
+// and ctx.Err() will be returned.
+func Chunked[I, O any](ctx context.Context, items []I, chunkSize int, fn func(context.Context, []I) ([]O, error), opts ...Option) Promise[[]O] {
+	if newOptions(opts).policy == BestEffort {
+		return Rejected[[]O](ErrUnsupportedPolicy)
+	}
+	if chunkSize < 1 {
+		chunkSize = max(len(items), 1)
+	}
+	chunks := make([][]I, 0, (len(items)+chunkSize-1)/chunkSize)
+	for start := 0; start < len(items); start += chunkSize {
+		end := min(start+chunkSize, len(items))
+		// cap the chunk so that fn appending to it cannot overwrite the next
+		chunks = append(chunks, items[start:end:end])
+	}
+
+	results := ForEach(ctx, chunks, 0, fn, opts...)
+	return Me(context.Background(), func() ([]O, error) {
+		outs, err := results()
+		if outs == nil {
+			return nil, err
+		}
+
+		n := 0
+		for _, out := range outs {
+			n += len(out)
+		}
+		flat := make([]O, 0, n)
+		for _, out := range outs {
+			flat = append(flat, out...)
+		}
+		return flat, err
+	})
+}
@@ -0,0 +1,91 @@
+package promise_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+// TestChunked ensures expected behavior of promise.Chunked in the happy path
+// 1. items are split into chunks of at most chunkSize
+// 2. the results of every chunk are concatenated in the order of items
+// 3. a chunkSize less than 1 processes items as a single chunk
+func TestChunked(t *testing.T) {
+	items := []int{0, 1, 2, 3, 4, 5, 6}
+	var (
+		mu      sync.Mutex
+		sizes   []int
+		largest int
+	)
+	double := func(_ context.Context, chunk []int) ([]int, error) {
+		mu.Lock()
+		sizes = append(sizes, len(chunk))
+		largest = max(largest, len(chunk))
+		mu.Unlock()
+		out := make([]int, len(chunk))
+		for i, v := range chunk {
+			out[i] = v * 2
+		}
+		return out, nil
+	}
+
+	av, ae := promise.Chunked(context.Background(), items, 3, double)()
+	expect(t, nil, ae)
+	expect(t, 3, len(sizes))
+	expect(t, 3, largest)
+	expect(t, len(items), len(av))
+	for i, v := range items {
+		expect(t, v*2, av[i])
+	}
+
+	sizes = nil
+	av, ae = promise.Chunked(context.Background(), items, 0, double)()
+	expect(t, nil, ae)
+	expect(t, 1, len(sizes))
+	expect(t, len(items), len(av))
+
+	av, ae = promise.Chunked(context.Background(), []int(nil), 3, double)()
+	expect(t, nil, ae)
+	expect(t, 0, len(av))
+}
+
+// TestChunkedError ensures that promise.Chunked returns a nil slice and the error of a failing chunk,
+// and rejects BestEffort, under which the results could not be aligned with items
+func TestChunkedError(t *testing.T) {
+	err := errors.New("some error")
+	fn := func(_ context.Context, chunk []int) ([]int, error) {
+		if chunk[0] == 2 {
+			return nil, err
+		}
+		return chunk, nil
+	}
+
+	av, ae := promise.Chunked(context.Background(), []int{0, 1, 2, 3, 4}, 2, fn)()
+	expect(t, true, av == nil)
+	expect(t, err, ae)
+
+	av, ae = promise.Chunked(context.Background(), []int{0, 1, 2, 3, 4}, 2, fn, promise.WithFailurePolicy(promise.CollectAll))()
+	expect(t, true, av == nil)
+	expect(t, true, errors.Is(ae, err))
+
+	av, ae = promise.Chunked(context.Background(), []int{0, 1, 2, 3, 4}, 2, fn, promise.WithFailurePolicy(promise.BestEffort))()
+	expect(t, true, av == nil)
+	expect(t, true, errors.Is(ae, promise.ErrUnsupportedPolicy))
+}
+
+// TestChunkedCancelled ensures that promise.Chunked returns a nil slice and an error wrapping
+// ErrNotCompleted and ctx.Err() when the context is done first
+func TestChunkedCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	av, ae := promise.Chunked(ctx, []int{0, 1, 2}, 1, func(ctx context.Context, chunk []int) ([]int, error) {
+		<-ctx.Done()
+		return chunk, nil
+	})()
+	expect(t, true, av == nil)
+	expectNotCompleted(t, ctx, ae)
+}
@@ -0,0 +1,77 @@
+package promise
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+type (
+	// Clock is the source of time for every timeout, delay, and timing in the package.
+	// It may be replaced with SetClock, such as by a fake clock in tests, so that time-based
+	// behavior can be tested deterministically without real sleeps.
+	Clock interface {
+		// Now returns the current time
+		Now() time.Time
+		// AfterFunc calls f, in its own goroutine, once d has passed
+		AfterFunc(d time.Duration, f func()) Timer
+		// NewTimer returns a Timer that sends the time on its channel once d has passed
+		NewTimer(d time.Duration) Timer
+	}
+
+	// Timer is a single event created by a Clock, with the same semantics as time.Timer
+	Timer interface {
+		// C returns the channel on which the time is sent, or nil for a Timer from AfterFunc
+		C() <-chan time.Time
+		// Stop prevents the Timer from firing, reporting if it was stopped before it fired
+		Stop() bool
+		// Reset changes the Timer to fire after d, reporting if it had been active
+		Reset(d time.Duration) bool
+	}
+
+	realClock struct{}
+
+	realTimer struct {
+		*time.Timer
+	}
+
+	clockHolder struct {
+		Clock
+	}
+)
+
+var globalClock atomic.Pointer[clockHolder]
+
+// SetClock sets the Clock used by the package from the call onwards.
+// A nil Clock restores the real clock. SetClock is intended for tests;
+// see promisetest.Clock for a fake implementation.
+func SetClock(c Clock) {
+	if c == nil {
+		globalClock.Store(nil)
+		return
+	}
+	globalClock.Store(&clockHolder{c})
+}
+
+// clock returns the currently set Clock
+func clock() Clock {
+	if h := globalClock.Load(); h != nil {
+		return h.Clock
+	}
+	return realClock{}
+}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) AfterFunc(d time.Duration, f func()) Timer {
+	return realTimer{time.AfterFunc(d, f)}
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return realTimer{time.NewTimer(d)}
+}
+
+func (t realTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
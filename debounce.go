@@ -0,0 +1,61 @@
+package promise
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type (
+	debouncer[T any] struct {
+		ctx      context.Context
+		window   time.Duration
+		complete func(context.Context) (T, error)
+
+		mu      sync.Mutex
+		pending *Future[T]
+		timer   Timer
+	}
+)
+
+// Debounce returns a trigger function providing a Promise for the result of complete.
+// complete is started once window has passed without another trigger, so a burst of triggers,
+// each within window of the last, coalesces into a single run whose Promise is shared by all of them.
+// A trigger after complete has started begins a new burst.
+// ctx is passed to complete. If the Context is done before complete, the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func Debounce[T any](ctx context.Context, window time.Duration, complete func(context.Context) (T, error)) func() Promise[T] {
+	d := &debouncer[T]{
+		ctx:      ctx,
+		window:   window,
+		complete: complete,
+	}
+	return d.trigger
+}
+
+func (d *debouncer[T]) trigger() Promise[T] {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	// a timer that cannot be stopped has already started complete
+	if d.pending != nil && d.timer.Stop() {
+		d.timer.Reset(d.window)
+		return d.pending.Promise()
+	}
+
+	f := NewFuture[T](d.ctx)
+	d.pending = f
+	d.timer = clock().AfterFunc(d.window, func() {
+		d.mu.Lock()
+		if d.pending == f {
+			d.pending = nil
+		}
+		d.mu.Unlock()
+		if d.ctx.Err() != nil {
+			// f has already been abandoned
+			return
+		}
+		f.Complete(d.complete(d.ctx))
+	})
+	return f.Promise()
+}
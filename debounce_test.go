@@ -0,0 +1,57 @@
+package promise_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+	"github.com/nabowler/promise/promisetest"
+)
+
+// TestDebounce ensures expected behavior of promise.Debounce
+// 1. a burst of triggers shares a single run of complete
+// 2. a trigger after the window begins a new run
+func TestDebounce(t *testing.T) {
+	c := promisetest.NewClock(t)
+	var calls atomic.Int32
+	trigger := promise.Debounce(context.Background(), 20*time.Millisecond, func(context.Context) (int32, error) {
+		return calls.Add(1), nil
+	})
+
+	var ps []promise.Promise[int32]
+	for i := 0; i < 5; i++ {
+		ps = append(ps, trigger())
+		c.Advance(10 * time.Millisecond)
+	}
+	expect(t, int32(0), calls.Load())
+	c.Advance(10 * time.Millisecond)
+	for _, p := range ps {
+		av, ae := p()
+		expect(t, int32(1), av)
+		expect(t, nil, ae)
+	}
+
+	p := trigger()
+	c.Advance(20 * time.Millisecond)
+	av, ae := p()
+	expect(t, int32(2), av)
+	expect(t, nil, ae)
+	expect(t, int32(2), calls.Load())
+}
+
+// TestDebounceCancelled ensures that promise.Debounce returns an error wrapping ErrNotCompleted and ctx.Err()
+// when the context is done before complete
+func TestDebounceCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	trigger := promise.Debounce(ctx, time.Second, func(context.Context) (string, error) {
+		return "test", nil
+	})
+	p := trigger()
+	cancel()
+
+	av, ae := p()
+	expect(t, "", av)
+	expectNotCompleted(t, ctx, ae)
+}
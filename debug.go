@@ -0,0 +1,117 @@
+package promise
+
+import (
+	"runtime/debug"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type (
+	// Debug describes where a pending Promise was created, to identify a Promise that an await is hung on
+	Debug struct {
+		// Label is the label given with WithLabel, if any
+		Label string
+		// Created is when the Promise was created
+		Created time.Time
+		// Stack is the stack trace of the goroutine that created the Promise
+		Stack []byte
+		// Waiters is the number of callers blocked on the Promise
+		Waiters int
+	}
+
+	// debugRecord is kept by a Future created while debug info is enabled
+	debugRecord struct {
+		label string
+		stack []byte
+	}
+
+	debugger interface {
+		debugInfo() Debug
+	}
+)
+
+var (
+	debugEnabled atomic.Bool
+
+	debugMu sync.Mutex
+	// debugPending holds every Future created while debug info is enabled, until it is settled
+	debugPending = map[debugger]struct{}{}
+)
+
+// EnableDebugInfo records the creation stack trace of every Promise created after the call,
+// retrievable with DebugInfo and PendingDebugInfo until the Promise is settled.
+// This captures a stack trace for every Promise so is intended for debugging, not production use.
+func EnableDebugInfo() {
+	debugEnabled.Store(true)
+}
+
+// DisableDebugInfo stops recording new Promises. Promises that are already recorded remain available.
+func DisableDebugInfo() {
+	debugEnabled.Store(false)
+}
+
+// WithLabel names the Promise in its Debug info, when EnableDebugInfo has been called,
+// and in the report of Tracker.Drain.
+func WithLabel(label string) Option {
+	return func(o *options) {
+		o.label = label
+	}
+}
+
+// DebugInfo returns the Debug info of p.
+// ok will be false if p was not created while debug info was enabled, or has since been settled.
+func DebugInfo[T any](p Promise[T]) (d Debug, ok bool) {
+	f := futureOf(p)
+	if f == nil || f.debug == nil || f.settled.Load() {
+		return d, false
+	}
+	return f.debugInfo(), true
+}
+
+// PendingDebugInfo returns the Debug info of every recorded Promise that is still pending,
+// from oldest to newest.
+func PendingDebugInfo() []Debug {
+	debugMu.Lock()
+	rs := make([]debugger, 0, len(debugPending))
+	for r := range debugPending {
+		rs = append(rs, r)
+	}
+	debugMu.Unlock()
+
+	ds := make([]Debug, 0, len(rs))
+	for _, r := range rs {
+		ds = append(ds, r.debugInfo())
+	}
+	slices.SortFunc(ds, func(a, b Debug) int {
+		return a.Created.Compare(b.Created)
+	})
+	return ds
+}
+
+// watchDebug records the creation of f if debug info is enabled. It must be called once f is initialized.
+func (f *Future[T]) watchDebug(o options) {
+	if !debugEnabled.Load() {
+		return
+	}
+
+	f.debug = &debugRecord{label: o.label, stack: debug.Stack()}
+	debugMu.Lock()
+	debugPending[f] = struct{}{}
+	debugMu.Unlock()
+	f.OnComplete(func(T, error) {
+		debugMu.Lock()
+		defer debugMu.Unlock()
+		delete(debugPending, f)
+	})
+}
+
+func (f *Future[T]) debugInfo() Debug {
+	return Debug{
+		Label:   f.debug.label,
+		Created: f.created,
+		Stack:   f.debug.stack,
+		Waiters: int(f.waiters.Load()),
+	}
+}
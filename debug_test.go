@@ -0,0 +1,56 @@
+package promise_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestDebugInfo ensures expected behavior of promise.DebugInfo
+// 1. a pending Promise created while debug info is enabled reports its label, creation stack, and waiters
+// 2. the Promise is no longer reported once settled
+// 3. Promises created while debug info is disabled are not reported
+func TestDebugInfo(t *testing.T) {
+	promise.EnableDebugInfo()
+	defer promise.DisableDebugInfo()
+
+	p, c := createDebugged()
+	go p()
+	// give the waiter time to block
+	time.Sleep(10 * time.Millisecond)
+
+	d, ok := promise.DebugInfo(p)
+	expect(t, true, ok)
+	expect(t, "test label", d.Label)
+	expect(t, true, strings.Contains(string(d.Stack), "createDebugged"))
+	expect(t, 1, d.Waiters)
+	expect(t, false, d.Created.IsZero())
+
+	found := false
+	for _, d := range promise.PendingDebugInfo() {
+		found = found || d.Label == "test label"
+	}
+	expect(t, true, found)
+
+	c("test", nil)
+	p()
+	// the record is removed by an OnComplete callback
+	for deadline := time.Now().Add(time.Second); ok && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+		_, ok = promise.DebugInfo(p)
+	}
+	expect(t, false, ok)
+
+	promise.DisableDebugInfo()
+	untracked, _ := promise.You[string](context.Background(), promise.WithLabel("untracked"))
+	_, ok = promise.DebugInfo(untracked)
+	expect(t, false, ok)
+}
+
+// createDebugged returns a labelled Promise and its Complete
+func createDebugged() (promise.Promise[string], promise.Complete[string]) {
+	return promise.You[string](context.Background(), promise.WithLabel("test label"))
+}
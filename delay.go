@@ -0,0 +1,35 @@
+package promise
+
+import (
+	"context"
+	"time"
+)
+
+// After returns a Promise that will provide t and a nil error once d has passed.
+// If the Context is done first, the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func After[T any](ctx context.Context, d time.Duration, t T) Promise[T] {
+	f := NewFuture[T](ctx)
+
+	timer := clock().AfterFunc(d, func() {
+		f.Complete(t, nil)
+	})
+	// release the timer early if the Context is done first
+	f.OnComplete(func(T, error) {
+		timer.Stop()
+	})
+
+	return f.Promise()
+}
+
+// Delay returns a Promise that will provide the result of p once both p is resolved
+// and d has passed since Delay was called.
+func Delay[T any](p Promise[T], d time.Duration) Promise[T] {
+	timer := clock().NewTimer(d)
+
+	return Me(context.Background(), func() (T, error) {
+		t, err := p()
+		<-timer.C()
+		return t, err
+	})
+}
@@ -0,0 +1,60 @@
+package promise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+	"github.com/nabowler/promise/promisetest"
+)
+
+// TestAfter ensures expected behavior of promise.After
+// 1. the value is not provided before d has passed
+// 2. the value and a nil error are returned on all calls
+func TestAfter(t *testing.T) {
+	c := promisetest.NewClock(t)
+	p := promise.After(context.Background(), 20*time.Millisecond, "test")
+	c.Advance(19 * time.Millisecond)
+	promisetest.AssertPendingFor(t, p, time.Millisecond)
+
+	c.Advance(time.Millisecond)
+	for i := 0; i < 10; i++ {
+		av, ae := p()
+		expect(t, "test", av)
+		expect(t, nil, ae)
+	}
+}
+
+// TestAfterCancelled ensures expected behavior of promise.After when the context is done
+func TestAfterCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	av, ae := promise.After(ctx, time.Hour, "test")()
+	expect(t, "", av)
+	expectNotCompleted(t, ctx, ae)
+}
+
+// TestDelay ensures expected behavior of promise.Delay
+// 1. the result of an already resolved Promise is not provided before d has passed
+// 2. the result of a Promise resolved after d is provided as soon as it is resolved
+func TestDelay(t *testing.T) {
+	c := promisetest.NewClock(t)
+	err := fmt.Errorf("some error")
+	p := promise.Delay(promise.Rejected[string](err), 20*time.Millisecond)
+	promisetest.AssertPendingFor(t, p, time.Millisecond)
+	c.Advance(20 * time.Millisecond)
+	av, ae := promisetest.AssertSettledWithin(t, p, time.Second)
+	expect(t, "", av)
+	expect(t, err, ae)
+
+	p = promise.Delay(promise.After(context.Background(), 30*time.Millisecond, "test"), time.Millisecond)
+	c.Advance(time.Millisecond)
+	promisetest.AssertPendingFor(t, p, time.Millisecond)
+	c.Advance(29 * time.Millisecond)
+	av, ae = promisetest.AssertSettledWithin(t, p, time.Second)
+	expect(t, "test", av)
+	expect(t, nil, ae)
+}
@@ -0,0 +1,66 @@
+package promise
+
+import (
+	"context"
+	"slices"
+)
+
+type (
+	// derivedView is a view of a Future kept by Derive
+	derivedView struct {
+		key string
+		// view is the *Future[U] of the view
+		view any
+	}
+)
+
+// maxDerived bounds the number of views kept by Derive for each Promise
+const maxDerived = 16
+
+// Derive returns a Promise that will provide the result of fn applied to the value of p, as Then,
+// but every call for the same p and key shares one Promise, so a view such as the parsed body
+// of a fetched payload is computed once for all of its consumers.
+// key must identify both fn and U: a call with a key already used for a different U replaces that view.
+// The views are kept with p, and released with it, but only the most recently used few are kept
+// for each Promise, so fn may run again for a view that has not been asked for in a long time.
+// Views are only shared for Promises created from a Future, as by Me, You, and most combinators;
+// for any other Promise, Derive is Then.
+// fn is only called if p resolves with a nil error; otherwise the default value for U
+// and the error from p will be returned.
+func Derive[T, U any](p Promise[T], key string, fn func(T) (U, error)) Promise[U] {
+	f := futureOf(p)
+	if f == nil {
+		return Then(p, fn)
+	}
+
+	f.mu.Lock()
+	for i, d := range f.derived {
+		if d.key != key {
+			continue
+		}
+		f.derived = slices.Delete(f.derived, i, i+1)
+		if view, ok := d.view.(*Future[U]); ok {
+			// move the view to the end, as the most recently used
+			f.derived = append(f.derived, d)
+			f.mu.Unlock()
+			return view.Promise()
+		}
+		break
+	}
+	view := NewFuture[U](context.Background())
+	if len(f.derived) == maxDerived {
+		f.derived = slices.Delete(f.derived, 0, 1)
+	}
+	f.derived = append(f.derived, derivedView{key: key, view: view})
+	f.mu.Unlock()
+
+	f.OnComplete(func(t T, err error) {
+		if err != nil {
+			var u U
+			view.Complete(u, err)
+			return
+		}
+		view.Complete(fn(t))
+	})
+	return view.Promise()
+}
@@ -0,0 +1,96 @@
+package promise_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+// TestDerive ensures expected behavior of promise.Derive
+// 1. every call for the same Promise and key shares one Promise, and fn is called once
+// 2. different keys and different Promises are derived separately
+// 3. the error of the Promise is returned without calling fn
+func TestDerive(t *testing.T) {
+	var calls atomic.Int32
+	atoi := func(s string) (int, error) {
+		calls.Add(1)
+		return strconv.Atoi(s)
+	}
+
+	p := promise.Me(context.Background(), func() (string, error) { return "42", nil })
+	for i := 0; i < 10; i++ {
+		av, ae := promise.Derive(p, "atoi", atoi)()
+		expect(t, 42, av)
+		expect(t, nil, ae)
+	}
+	expect(t, int32(1), calls.Load())
+
+	av, ae := promise.Derive(p, "len", func(s string) (int, error) { return len(s), nil })()
+	expect(t, 2, av)
+	expect(t, nil, ae)
+	other := promise.Me(context.Background(), func() (string, error) { return "7", nil })
+	av, ae = promise.Derive(other, "atoi", atoi)()
+	expect(t, 7, av)
+	expect(t, nil, ae)
+	expect(t, int32(2), calls.Load())
+
+	err := fmt.Errorf("some error")
+	av, ae = promise.Derive(promise.Rejected[string](err), "atoi", atoi)()
+	expect(t, 0, av)
+	expect(t, err, ae)
+	expect(t, int32(2), calls.Load())
+}
+
+// TestDeriveFuture ensures that promise.Derive shares views between the Promises of a Future
+// 1. every call to Future.Promise shares the same views
+// 2. views of Promises not created from a Future are not shared
+// 3. only the most recently used views are kept
+func TestDeriveFuture(t *testing.T) {
+	var calls atomic.Int32
+	atoi := func(s string) (int, error) {
+		calls.Add(1)
+		return strconv.Atoi(s)
+	}
+
+	f := promise.NewFuture[string](context.Background())
+	view := promise.Derive(f.Promise(), "atoi", atoi)
+	f.Complete("42", nil)
+	for i := 0; i < 10; i++ {
+		av, ae := promise.Derive(f.Promise(), "atoi", atoi)()
+		expect(t, 42, av)
+		expect(t, nil, ae)
+	}
+	_, _ = view()
+	expect(t, int32(1), calls.Load())
+
+	plain := promise.FromFunc(func() (string, error) { return "7", nil })
+	for i := 0; i < 2; i++ {
+		av, _ := promise.Derive(plain, "atoi", atoi)()
+		expect(t, 7, av)
+	}
+	expect(t, int32(3), calls.Load())
+
+	for i := 0; i < 100; i++ {
+		_, _ = promise.Derive(f.Promise(), strconv.Itoa(i), atoi)()
+	}
+	calls.Store(0)
+	_, _ = promise.Derive(f.Promise(), "atoi", atoi)()
+	_, _ = promise.Derive(f.Promise(), "99", atoi)()
+	expect(t, int32(1), calls.Load())
+}
+
+// TestDeriveTypeMismatch ensures that promise.Derive does not share a view between different result types
+func TestDeriveTypeMismatch(t *testing.T) {
+	p := promise.Me(context.Background(), func() (string, error) { return "42", nil })
+	av, ae := promise.Derive(p, "view", strconv.Atoi)()
+	expect(t, 42, av)
+	expect(t, nil, ae)
+
+	bv, be := promise.Derive(p, "view", func(s string) (string, error) { return s + "!", nil })()
+	expect(t, "42!", bv)
+	expect(t, nil, be)
+}
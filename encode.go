@@ -0,0 +1,57 @@
+package promise
+
+import "encoding/json"
+
+type (
+	// RemoteError is the error of a Result decoded by Decode.
+	// Only the message of the original error survives encoding.
+	RemoteError struct {
+		Message string
+	}
+
+	// encodedResult is the JSON representation of a Result
+	encodedResult[T any] struct {
+		Value T       `json:"value"`
+		Err   *string `json:"error,omitempty"`
+	}
+)
+
+// Encode returns the JSON encoding of r, so that the outcome of a Promise may be sent to another process.
+// The error of r is encoded as its message.
+func Encode[T any](r Result[T]) ([]byte, error) {
+	e := encodedResult[T]{Value: r.Value}
+	if r.Err != nil {
+		msg := r.Err.Error()
+		e.Err = &msg
+	}
+	return json.Marshal(e)
+}
+
+// Decode returns the Result encoded in data by Encode.
+// A non-nil error of the encoded Result is decoded as a *RemoteError.
+func Decode[T any](data []byte) (Result[T], error) {
+	var e encodedResult[T]
+	if err := json.Unmarshal(data, &e); err != nil {
+		return Result[T]{}, err
+	}
+	r := Result[T]{Value: e.Value}
+	if e.Err != nil {
+		r.Err = &RemoteError{Message: *e.Err}
+	}
+	return r, nil
+}
+
+// CompleteFrom completes c with the Result encoded in data by Encode.
+// If data cannot be decoded, c is not called and the decoding error is returned.
+func CompleteFrom[T any](c Complete[T], data []byte) error {
+	r, err := Decode[T](data)
+	if err != nil {
+		return err
+	}
+	c(r.Unwrap())
+	return nil
+}
+
+func (e *RemoteError) Error() string {
+	return e.Message
+}
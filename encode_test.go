@@ -0,0 +1,48 @@
+package promise_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+// TestEncode ensures that a Result survives promise.Encode and promise.Decode
+// 1. the value is preserved
+// 2. the error is decoded as a *RemoteError with the same message
+func TestEncode(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			data, err := promise.Encode(promise.Result[string]{Value: tc.val, Err: tc.err})
+			expect(t, nil, err)
+
+			r, err := promise.Decode[string](data)
+			expect(t, nil, err)
+			expect(t, tc.val, r.Value)
+			if tc.err == nil {
+				expect(t, nil, r.Err)
+				return
+			}
+			var re *promise.RemoteError
+			expect(t, true, errors.As(r.Err, &re))
+			expect(t, tc.err.Error(), re.Message)
+		})
+	}
+}
+
+// TestCompleteFrom ensures expected behavior of promise.CompleteFrom
+// 1. the Promise is completed with the decoded Result
+// 2. undecodable data is an error and does not complete the Promise
+func TestCompleteFrom(t *testing.T) {
+	p, c := promise.You[int](context.Background())
+	expect(t, true, promise.CompleteFrom(c, []byte("not json")) != nil)
+
+	data, err := promise.Encode(promise.Ok(42))
+	expect(t, nil, err)
+	expect(t, nil, promise.CompleteFrom(c, data))
+	av, ae := p()
+	expect(t, 42, av)
+	expect(t, nil, ae)
+}
@@ -0,0 +1,58 @@
+package promise
+
+import "context"
+
+type (
+	// PromiseErrOnly is a blocking function that will return the same error on every call,
+	// for operations that have no value
+	PromiseErrOnly func() error
+
+	// CompleteErrOnly is a non-blocking function that will fulfill a Promise created by YouErrOnly
+	CompleteErrOnly func(error)
+)
+
+// MeErrOnly returns a PromiseErrOnly that will provide the result of complete.
+// If the Context is done before complete, an error wrapping both
+// ErrNotCompleted and ctx.Err() will be returned.
+func MeErrOnly(ctx context.Context, complete func() error) PromiseErrOnly {
+	p, c := YouErrOnly(ctx)
+
+	spawn(func() {
+		c(complete())
+	})
+
+	return p
+}
+
+// YouErrOnly returns a PromiseErrOnly and a Completion.
+// The Promise will block until Complete is called.
+// The first call to Complete will set the return value for the Promise.
+// Subsequent calls to Complete will no-op.
+// If the Context is done before Complete, an error wrapping both
+// ErrNotCompleted and ctx.Err() will be returned.
+func YouErrOnly(ctx context.Context) (PromiseErrOnly, CompleteErrOnly) {
+	f := NewFuture[struct{}](ctx)
+	h := watchLeak(f)
+
+	p := func() error {
+		_, err := f.Get(context.Background())
+		return err
+	}
+	complete := func(err error) {
+		h.keepAlive()
+		f.Complete(struct{}{}, err)
+	}
+
+	return p, complete
+}
+
+// Await blocks until the Promise is resolved or ctx is done, whichever happens first.
+// If ctx is done first, an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+// ctx only governs this call: the Promise, and any work fulfilling it, is unaffected
+// and other callers will continue to receive its eventual result.
+func (p PromiseErrOnly) Await(ctx context.Context) error {
+	_, err := Promise[struct{}](func() (struct{}, error) {
+		return struct{}{}, p()
+	}).Await(ctx)
+	return err
+}
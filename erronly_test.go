@@ -0,0 +1,64 @@
+package promise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+var errOnlyTestCases = map[string]error{
+	"noError": nil,
+	"error":   fmt.Errorf("some error"),
+}
+
+// TestMeErrOnly ensures expected behavior of promise.MeErrOnly in the happy path
+// 1. the expected error is returned when ctx is not done
+// 2. the expected error continues to be returned on all calls
+func TestMeErrOnly(t *testing.T) {
+	for name, testcase := range errOnlyTestCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			p := promise.MeErrOnly(context.Background(), func() error {
+				return tc
+			})
+			for i := 0; i < 10; i++ {
+				expect(t, tc, p())
+			}
+		})
+	}
+}
+
+// TestYouErrOnly ensures expected behavior of promise.YouErrOnly in the happy path
+// 1. the expected error is returned when ctx is not done
+// 2. subsequent calls to Complete do not change the returned error
+func TestYouErrOnly(t *testing.T) {
+	for name, testcase := range errOnlyTestCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			p, c := promise.YouErrOnly(context.Background())
+			c(tc)
+			for i := 0; i < 10; i++ {
+				c(fmt.Errorf("invalid error"))
+				expect(t, tc, p())
+			}
+		})
+	}
+}
+
+// TestYouErrOnlyCancelled ensures expected behavior of promise.YouErrOnly when the context is done
+// 1. an error wrapping ErrNotCompleted and ctx.Err() is returned
+// 2. a short Await ctx does not affect the Promise
+func TestYouErrOnlyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p, _ := promise.YouErrOnly(ctx)
+
+	short, cancelShort := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelShort()
+	expectNotCompleted(t, short, p.Await(short))
+
+	cancel()
+	expectNotCompleted(t, ctx, p())
+}
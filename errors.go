@@ -0,0 +1,117 @@
+package promise
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrNotCompleted is returned, wrapping ctx.Err(), when a Promise's Context is done before it was completed.
+	// This allows errors.Is to distinguish a Promise that was never completed from one that was
+	// completed with a Context error.
+	// If the Context was cancelled with a cause, the cause is wrapped as well.
+	ErrNotCompleted = errors.New("promise not completed")
+
+	// ErrTimeout is returned when a Promise with a timeout was not completed in time.
+	ErrTimeout = errors.New("promise timed out")
+
+	// ErrAwaitTimeout is returned by AwaitTimeout when the Promise was not resolved in time.
+	// Unlike ErrTimeout, the Promise itself is unaffected.
+	ErrAwaitTimeout = errors.New("await timed out")
+
+	// ErrChanClosed is returned by a Promise from FromChan when the channel is closed without a value.
+	ErrChanClosed = errors.New("channel closed without a value")
+
+	// ErrNoFunctions is returned by combinators that choose between functions when none are provided.
+	ErrNoFunctions = errors.New("no functions provided")
+
+	// ErrNoResult is returned by a Promise in a Batch that was completed without a Result for its key.
+	ErrNoResult = errors.New("no result for key")
+
+	// ErrNotEnough is returned, wrapping the errors of the failed Promises, by a Promise from NOf
+	// when too few of its Promises succeeded.
+	ErrNotEnough = errors.New("not enough promises succeeded")
+
+	// ErrScopeClosed is the cause of the Context of a Scope once it is closed,
+	// so is wrapped by the error of every Promise in the Scope that was not completed before Close.
+	ErrScopeClosed = errors.New("scope closed")
+
+	// ErrCircuitOpen is returned, wrapping the Breaker's error, by a Promise from MeWithBreaker
+	// when its Breaker does not allow the call.
+	ErrCircuitOpen = errors.New("circuit breaker open")
+
+	// ErrUnsupportedPolicy is returned by a Promise from Chunked when given a FailurePolicy it does not support.
+	ErrUnsupportedPolicy = errors.New("unsupported failure policy")
+)
+
+type (
+	// AggregateError is returned by First and Hedge when every function fails,
+	// so that the failure of each can be inspected, such as with errors.Is or errors.As.
+	AggregateError struct {
+		// Errors holds the error returned by each function, at the index of the function
+		Errors []error
+	}
+
+	notCompletedError struct {
+		err   error
+		cause error
+	}
+)
+
+// notCompleted returns an error wrapping ErrNotCompleted, ctx.Err(), and context.Cause(ctx).
+// ctx must be done.
+func notCompleted(ctx context.Context) error {
+	return notCompletedError{
+		err:   ctx.Err(),
+		cause: context.Cause(ctx),
+	}
+}
+
+func (e notCompletedError) Error() string {
+	return ErrNotCompleted.Error() + ": " + e.cause.Error()
+}
+
+func (e notCompletedError) Is(target error) bool {
+	return target == ErrNotCompleted
+}
+
+func (e notCompletedError) Unwrap() []error {
+	if e.cause == e.err {
+		return []error{e.err}
+	}
+	return []error{e.err, e.cause}
+}
+
+func (e *AggregateError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "promise: all %d functions failed", len(e.Errors))
+	for i, err := range e.Errors {
+		fmt.Fprintf(&b, "; %d: %v", i, err)
+	}
+	return b.String()
+}
+
+// Unwrap returns Errors
+func (e *AggregateError) Unwrap() []error {
+	return e.Errors
+}
+
+// IsTimeout reports if err is the result of a Promise, or a wait on one, running out of time:
+// ErrTimeout, ErrAwaitTimeout, or a Context deadline being exceeded.
+func IsTimeout(err error) bool {
+	return errors.Is(err, ErrTimeout) || errors.Is(err, ErrAwaitTimeout) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// IsCanceled reports if err is the result of a Context being cancelled,
+// such as a Promise abandoned because its Context was cancelled before it was completed.
+func IsCanceled(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
+
+// IsPanic reports if err is, or wraps, a *PanicError from a recovered producer
+func IsPanic(err error) bool {
+	var pe *PanicError
+	return errors.As(err, &pe)
+}
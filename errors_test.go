@@ -0,0 +1,98 @@
+package promise_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestErrNotCompleted ensures that a Promise completed with a Context error
+// can be distinguished from one that was never completed
+// 1. a Promise completed with context.Canceled does not wrap ErrNotCompleted
+// 2. a Promise whose ctx is done wraps both ErrNotCompleted and context.Canceled
+func TestErrNotCompleted(t *testing.T) {
+	p := promise.Me(context.Background(), func() (string, error) {
+		return "", context.Canceled
+	})
+	_, ae := p()
+	expect(t, context.Canceled, ae)
+	expect(t, false, errors.Is(ae, promise.ErrNotCompleted))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	p, _ = promise.You[string](ctx)
+	_, ae = p()
+	expectNotCompleted(t, ctx, ae)
+	expect(t, true, errors.Is(ae, context.Canceled))
+}
+
+// TestErrNotCompletedCause ensures that the cause of a cancelled Context is provided
+// 1. the error wraps ErrNotCompleted, ctx.Err(), and context.Cause(ctx)
+// 2. the cause is included in the error message
+func TestErrNotCompletedCause(t *testing.T) {
+	cause := errors.New("some cause")
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(cause)
+	release := make(chan struct{})
+	defer close(release)
+
+	for _, p := range []promise.Promise[string]{
+		promise.Me(ctx, func() (string, error) {
+			<-release
+			return "test", nil
+		}),
+		promise.NewFuture[string](ctx).Promise(),
+	} {
+		_, ae := p()
+		expectNotCompleted(t, ctx, ae)
+		expect(t, true, errors.Is(ae, cause))
+		expect(t, "promise not completed: some cause", ae.Error())
+	}
+}
+
+// TestClassification ensures that promise.IsTimeout, promise.IsCanceled, and promise.IsPanic
+// classify the errors provided by the package
+func TestClassification(t *testing.T) {
+	timedOut, _ := promise.You[string](context.Background(), promise.WithTimeout(time.Millisecond))
+	pending, _ := promise.You[string](context.Background())
+	deadline, cancelDeadline := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancelDeadline()
+	expired, _ := promise.You[string](deadline)
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	abandoned, _ := promise.You[string](cancelled)
+	panicked := promise.Me(context.Background(), func() (string, error) {
+		panic("oops")
+	}, promise.WithRecover())
+
+	_, awaitErr := promise.AwaitTimeout(pending, time.Millisecond)
+	errs := map[string]struct {
+		err                        error
+		timeout, canceled, panicky bool
+	}{
+		"ErrTimeout":      {err: errorOf(timedOut), timeout: true},
+		"ErrAwaitTimeout": {err: awaitErr, timeout: true},
+		"deadline":        {err: errorOf(expired), timeout: true},
+		"cancelled":       {err: errorOf(abandoned), canceled: true},
+		"panic":           {err: errorOf(panicked), panicky: true},
+		"other":           {err: errors.New("some error")},
+		"nil":             {},
+	}
+	for name, testcase := range errs {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			expect(t, tc.timeout, promise.IsTimeout(tc.err))
+			expect(t, tc.canceled, promise.IsCanceled(tc.err))
+			expect(t, tc.panicky, promise.IsPanic(tc.err))
+		})
+	}
+}
+
+// errorOf returns the error provided by p
+func errorOf[T any](p promise.Promise[T]) error {
+	_, err := p()
+	return err
+}
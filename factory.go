@@ -0,0 +1,46 @@
+package promise
+
+import "context"
+
+type (
+	// Factory creates Promises with a shared base Context and Options,
+	// so that a consistent policy can be configured once, for example at startup.
+	// A Factory is safe for concurrent use.
+	Factory struct {
+		ctx  context.Context
+		opts []Option
+	}
+)
+
+// NewFactory returns a Factory whose Promises use ctx and opts,
+// such as WithTimeout, WithRecover, and WithObserver.
+func NewFactory(ctx context.Context, opts ...Option) *Factory {
+	return &Factory{
+		ctx:  ctx,
+		opts: append([]Option(nil), opts...),
+	}
+}
+
+// FactoryMe is Me using the Context and Options of f.
+// opts are applied after those of f, so may override them.
+func FactoryMe[T any](f *Factory, complete func() (T, error), opts ...Option) Promise[T] {
+	return Me(f.ctx, complete, f.options(opts)...)
+}
+
+// FactoryYou is You using the Context and Options of f.
+// opts are applied after those of f, so may override them.
+func FactoryYou[T any](f *Factory, opts ...Option) (Promise[T], Complete[T]) {
+	return You[T](f.ctx, f.options(opts)...)
+}
+
+// Context returns the base Context of f
+func (f *Factory) Context() context.Context {
+	return f.ctx
+}
+
+func (f *Factory) options(opts []Option) []Option {
+	if len(opts) == 0 {
+		return f.opts
+	}
+	return append(append([]Option(nil), f.opts...), opts...)
+}
@@ -0,0 +1,45 @@
+package promise_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestFactory ensures expected behavior of promise.Factory
+// 1. Promises use the Options of the Factory
+// 2. Options passed per call override those of the Factory
+// 3. Promises use the Context of the Factory
+func TestFactory(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	f := promise.NewFactory(ctx, promise.WithRecover(), promise.WithTimeout(10*time.Millisecond))
+	expect(t, ctx, f.Context())
+
+	_, ae := promise.FactoryMe(f, func() (string, error) {
+		panic("oops")
+	})()
+	expect(t, true, promise.IsPanic(ae))
+
+	p, _ := promise.FactoryYou[string](f)
+	av, ae := p()
+	expect(t, "", av)
+	expect(t, promise.ErrTimeout, ae)
+
+	p, c := promise.FactoryYou[string](f, promise.WithTimeout(time.Second))
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		c("test", nil)
+	}()
+	av, ae = p()
+	expect(t, "test", av)
+	expect(t, nil, ae)
+
+	p, _ = promise.FactoryYou[string](f, promise.WithTimeout(time.Second))
+	cancel()
+	_, ae = p()
+	expect(t, true, errors.Is(ae, promise.ErrNotCompleted))
+}
@@ -0,0 +1,17 @@
+package promise
+
+import "sync"
+
+// FromFunc returns a Promise that will provide the result of fn.
+// Like sync.OnceValues, fn is called on the calling goroutine by the first call to the Promise,
+// every other call blocks until it returns, and all calls return the same result.
+// If fn panics, every call to the Promise will panic with the same value.
+func FromFunc[T any](fn func() (T, error)) Promise[T] {
+	return Promise[T](sync.OnceValues(fn))
+}
+
+// ToFunc returns p as a plain function, for use with APIs expecting the result of sync.OnceValues.
+// The returned function blocks until p is resolved, and returns the same result on every call.
+func ToFunc[T any](p Promise[T]) func() (T, error) {
+	return p
+}
@@ -0,0 +1,50 @@
+package promise_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+// TestFromFunc ensures expected behavior of promise.FromFunc
+// 1. fn is not called until the Promise is
+// 2. fn is called at most once
+// 3. the expected value and error are returned on all calls
+func TestFromFunc(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			p := promise.FromFunc(func() (string, error) {
+				calls++
+				return tc.val, tc.err
+			})
+			expect(t, 0, calls)
+
+			for i := 0; i < 10; i++ {
+				av, ae := p()
+				expect(t, tc.val, av)
+				expect(t, tc.err, ae)
+			}
+			expect(t, 1, calls)
+		})
+	}
+}
+
+// TestToFunc ensures that promise.ToFunc interoperates with sync.OnceValues
+func TestToFunc(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			p, c := promise.You[string](context.Background())
+			fn := sync.OnceValues(promise.ToFunc(p))
+			c(tc.val, tc.err)
+
+			av, ae := fn()
+			expect(t, tc.val, av)
+			expect(t, tc.err, ae)
+		})
+	}
+}
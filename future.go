@@ -0,0 +1,364 @@
+package promise
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type (
+	// Future holds the eventual result of an asynchronous operation.
+	// A Future is settled exactly once, either by Complete or by its Context being done,
+	// after which every call to Get will return the same (T, error).
+	// The function-style Promise and Complete types are thin adapters around a Future.
+	// A Future may be created with NewFuture, or declared as a value and prepared with Init,
+	// for example as an element of an array, to avoid a separate allocation.
+	// A Future must not be copied after first use.
+	Future[T any] struct {
+		ctx  context.Context
+		stop func() bool
+
+		observer Observer
+		created  time.Time
+
+		once sync.Once
+		// settled is set once val, err, and state are final, so that reading a settled Future
+		// is a single atomic load; done is only needed by callers that must block,
+		// so is only allocated once one does
+		settled atomic.Bool
+		done    chan struct{}
+		state   State
+		val     T
+		err     error
+
+		mu        sync.Mutex
+		callbacks []func(T, error)
+		timings   Timings
+		// derived holds the views kept by Derive, from least to most recently used
+		derived []derivedView
+
+		// waiters is the number of callers blocked in Get
+		waiters atomic.Int32
+
+		// debug is only set while debug info is enabled
+		debug *debugRecord
+	}
+
+	// State describes the progress of a Future
+	State int
+
+	// Timings records when a Future reached each point of its lifecycle.
+	// Points not yet reached are the zero time.
+	Timings struct {
+		// Created is when the Future was created
+		Created time.Time
+		// Started is when the producer of a Promise created by Me or MeNoError was started
+		Started time.Time
+		// Waited is when a caller first blocked in Get waiting for the Future to settle
+		Waited time.Time
+		// Settled is when the Future was settled
+		Settled time.Time
+	}
+
+	// Stater is implemented by values that can report their State, such as Future.
+	// It allows monitoring code to inspect Futures regardless of their type parameter.
+	Stater interface {
+		State() State
+	}
+)
+
+// closedChan is returned by Done for settled Futures that were never waited on
+var closedChan = func() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}()
+
+const (
+	// StatePending Futures have not been settled
+	StatePending State = iota
+	// StateFulfilled Futures were completed with a nil error
+	StateFulfilled
+	// StateRejected Futures were completed with a non-nil error
+	StateRejected
+	// StateCancelled Futures had their Context done before they were completed
+	StateCancelled
+)
+
+// NewFuture returns a pending Future.
+// If the Context is done before Complete, the Future will be settled with the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err().
+func NewFuture[T any](ctx context.Context, opts ...Option) *Future[T] {
+	return newFuture[T](ctx, newOptions(opts))
+}
+
+func newFuture[T any](ctx context.Context, o options) *Future[T] {
+	f := &Future[T]{}
+	f.init(ctx, o)
+	return f
+}
+
+// Init prepares a Future declared as a value, such as an element of an array, for use.
+// It is equivalent to NewFuture, without allocating the Future itself: watching a Context that can be done,
+// options, and each call to Promise still allocate, so only a Future on a Context that can never be done,
+// such as context.Background, that is completed before it is waited on is free of allocations.
+// Init must only be called on a zero-value Future, before it is shared. A settled Future must not be
+// reused with Init, as callbacks registered by its options, or by its callers, may still be using it.
+func (f *Future[T]) Init(ctx context.Context, opts ...Option) {
+	f.init(ctx, newOptions(opts))
+}
+
+func (f *Future[T]) init(ctx context.Context, o options) {
+	ctx = o.producerContext(ctx)
+	*f = Future[T]{
+		ctx:     ctx,
+		created: clock().Now(),
+	}
+	f.timings.Created = f.created
+	if o.observer != nil {
+		f.observer = o.observer
+		o.observer.Created()
+	}
+
+	// a Context that can never be done, such as context.Background, needs no watch
+	if ctx.Done() != nil {
+		f.stop = context.AfterFunc(ctx, f.abandon)
+	}
+	if o.timings != nil {
+		f.OnComplete(func(T, error) {
+			o.timings(f.Timings())
+		})
+	}
+	if o.timeout > 0 {
+		timer := clock().AfterFunc(o.timeout, func() {
+			var t T
+			f.Complete(t, ErrTimeout)
+		})
+		f.OnComplete(func(T, error) {
+			timer.Stop()
+		})
+	}
+	f.watchDebug(o)
+}
+
+// Complete settles the Future with t and err.
+// The first call to Complete will set the result of the Future.
+// Subsequent calls, or calls after the Future's Context is done, will no-op.
+// Complete will never block.
+func (f *Future[T]) Complete(t T, err error) {
+	if f.ctx.Err() != nil {
+		// prefer reporting that the Context was done over a result that raced it
+		f.abandon()
+		return
+	}
+	state := StateFulfilled
+	if err != nil {
+		state = StateRejected
+	}
+	f.settle(t, err, state)
+	// release the Context watch; abandon need not, as it is only called once the Context is done
+	if f.stop != nil {
+		f.stop()
+	}
+}
+
+// Get blocks until the Future is settled or ctx is done, whichever happens first.
+// If ctx is done first, the default value for T and an error wrapping both
+// ErrNotCompleted and ctx.Err() will be returned. ctx only governs this call and
+// does not settle the Future.
+func (f *Future[T]) Get(ctx context.Context) (T, error) {
+	if !f.wait(ctx.Done(), nil) {
+		var t T
+		return t, notCompleted(ctx)
+	}
+	return f.val, f.err
+}
+
+// wait blocks until the Future is settled, reporting true, or until either done or expired
+// is ready, reporting false. Either may be nil to only wait on the other.
+func (f *Future[T]) wait(done <-chan struct{}, expired <-chan time.Time) bool {
+	if f.settled.Load() {
+		return true
+	}
+
+	f.waiters.Add(1)
+	defer f.waiters.Add(-1)
+	f.mu.Lock()
+	if f.timings.Waited.IsZero() {
+		f.timings.Waited = clock().Now()
+	}
+	f.mu.Unlock()
+	select {
+	case <-f.Done():
+	case <-f.ctx.Done():
+		f.abandon()
+	case <-done:
+		return false
+	case <-expired:
+		return false
+	}
+	return true
+}
+
+// Done returns a channel that is closed once the Future is settled.
+func (f *Future[T]) Done() <-chan struct{} {
+	if f.settled.Load() {
+		return closedChan
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	// settle marks the Future settled before closing any channel under mu
+	if f.settled.Load() {
+		return closedChan
+	}
+	if f.done == nil {
+		f.done = make(chan struct{})
+	}
+	return f.done
+}
+
+// State reports the current State of the Future without blocking.
+// A Future whose Context is done will report StateCancelled even if nobody has waited on it.
+func (f *Future[T]) State() State {
+	if f.settled.Load() {
+		return f.state
+	}
+
+	if f.ctx.Err() != nil {
+		f.abandon()
+		return f.state
+	}
+	return StatePending
+}
+
+// Timings reports when the Future reached each point of its lifecycle.
+// Settled minus Created is the latency of the producer, while Settled minus Waited
+// is how long callers were blocked on it.
+func (f *Future[T]) Timings() Timings {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.timings
+}
+
+// Then returns a Future that will be settled with the result of fn applied to the value of f.
+// fn is only called if f is fulfilled; otherwise the returned Future is settled with
+// the same value and error as f.
+func (f *Future[T]) Then(fn func(T) (T, error)) *Future[T] {
+	next := NewFuture[T](f.ctx)
+
+	f.OnComplete(func(t T, err error) {
+		if err == nil {
+			t, err = fn(t)
+		}
+		next.Complete(t, err)
+	})
+
+	return next
+}
+
+// OnComplete registers fn to be called with the result of the Future once it is settled.
+// Each registered fn is called exactly once, in its own goroutine.
+// If the Future is already settled, fn is called immediately.
+func (f *Future[T]) OnComplete(fn func(T, error)) {
+	f.mu.Lock()
+	if f.settled.Load() {
+		f.mu.Unlock()
+		t, err := f.val, f.err
+		spawn(func() { fn(t, err) })
+		return
+	}
+	f.callbacks = append(f.callbacks, fn)
+	f.mu.Unlock()
+}
+
+// OnAbandoned registers fn to be called, in its own goroutine, if the Future's Context is done
+// before it is completed, so that a producer may release resources rather than finish work
+// nobody will receive. fn is never called if the Future is completed first.
+// If the Future has already been abandoned, fn is called immediately.
+func (f *Future[T]) OnAbandoned(fn func()) {
+	f.OnComplete(func(T, error) {
+		if f.state == StateCancelled {
+			fn()
+		}
+	})
+}
+
+// Promise returns a Promise that will block until the Future is settled.
+// StateOf, OnComplete, and the other functions taking a Promise can trace it back to the Future.
+func (f *Future[T]) Promise() Promise[T] {
+	return f.promise()
+}
+
+// PromiseNoError returns a PromiseNoError that will block until the Future is settled.
+// The Future's error is ignored.
+func (f *Future[T]) PromiseNoError() PromiseNoError[T] {
+	return func() T {
+		t, _ := f.Get(context.Background())
+		return t
+	}
+}
+
+// abandon settles the Future because its Context is done
+func (f *Future[T]) abandon() {
+	var t T
+	f.settle(t, notCompleted(f.ctx), StateCancelled)
+}
+
+func (f *Future[T]) settle(t T, err error, state State) {
+	f.once.Do(func() {
+		f.val, f.err, f.state = t, err, state
+		f.settled.Store(true)
+		f.observe()
+
+		f.mu.Lock()
+		f.timings.Settled = clock().Now()
+		if f.done != nil {
+			close(f.done)
+		}
+		callbacks := f.callbacks
+		f.callbacks = nil
+		f.mu.Unlock()
+
+		for _, fn := range callbacks {
+			spawn(func() { fn(t, err) })
+		}
+	})
+}
+
+// start records that the producer of the Future has started
+func (f *Future[T]) start() {
+	f.mu.Lock()
+	f.timings.Started = clock().Now()
+	f.mu.Unlock()
+}
+
+// observe notifies the Future's Observer, if any, that it has been settled
+func (f *Future[T]) observe() {
+	if f.observer == nil {
+		return
+	}
+	d := clock().Now().Sub(f.created)
+	if f.state == StateCancelled {
+		f.observer.Cancelled(d, f.err)
+		return
+	}
+	f.observer.Settled(d, f.err)
+}
+
+// String returns the name of the State
+func (s State) String() string {
+	switch s {
+	case StatePending:
+		return "Pending"
+	case StateFulfilled:
+		return "Fulfilled"
+	case StateRejected:
+		return "Rejected"
+	case StateCancelled:
+		return "Cancelled"
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,283 @@
+package promise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestFuture ensures expected behavior of promise.Future in the happy path
+// 1. the Future is pending and Done is open before Complete
+// 2. the expected value and error are returned when ctx is not done
+// 3. the expected value and error continue to be returned on all calls
+// 4. subsequent calls to Complete do not change the returned values
+func TestFuture(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			f := promise.NewFuture[string](context.Background())
+			expect(t, promise.StatePending, f.State())
+			select {
+			case <-f.Done():
+				t.Errorf("expected Done to be open")
+			default:
+			}
+
+			f.Complete(tc.val, tc.err)
+			<-f.Done()
+
+			expectedState := promise.StateFulfilled
+			if tc.err != nil {
+				expectedState = promise.StateRejected
+			}
+			for i := 0; i < 10; i++ {
+				f.Complete("something invalid", fmt.Errorf("invalid error"))
+				av, ae := f.Get(context.Background())
+				expect(t, tc.val, av)
+				expect(t, tc.err, ae)
+				expect(t, expectedState, f.State())
+			}
+		})
+	}
+}
+
+// TestFutureCancelled ensures expected behavior of promise.Future when its context is done
+// 1. the default value of T and an error wrapping ErrNotCompleted and ctx.Err() are returned
+// 2. the Future is cancelled and Done is closed
+// 3. subsequent calls to Complete do not change the returned values
+func TestFutureCancelled(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			f := promise.NewFuture[string](ctx)
+			cancel()
+
+			for i := 0; i < 10; i++ {
+				av, ae := f.Get(context.Background())
+				expect(t, "", av)
+				expectNotCompleted(t, ctx, ae)
+				expect(t, promise.StateCancelled, f.State())
+				<-f.Done()
+				f.Complete(tc.val, tc.err)
+			}
+		})
+	}
+}
+
+// TestFutureGetCancelled ensures that the ctx passed to Future.Get only governs that call
+// 1. an error wrapping ErrNotCompleted and the Get ctx.Err() is returned
+// 2. the Future remains pending
+func TestFutureGetCancelled(t *testing.T) {
+	f := promise.NewFuture[string](context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	av, ae := f.Get(ctx)
+	expect(t, "", av)
+	expectNotCompleted(t, ctx, ae)
+	expect(t, promise.StatePending, f.State())
+}
+
+// TestFutureThen ensures expected behavior of Future.Then
+// 1. fn is applied to the value of a fulfilled Future
+// 2. fn is not called for a rejected Future, and its error is passed through
+func TestFutureThen(t *testing.T) {
+	f := promise.NewFuture[string](context.Background())
+	next := f.Then(func(s string) (string, error) {
+		return s + "!", nil
+	})
+	f.Complete("test", nil)
+	av, ae := next.Get(context.Background())
+	expect(t, "test!", av)
+	expect(t, nil, ae)
+
+	err := fmt.Errorf("some error")
+	f = promise.NewFuture[string](context.Background())
+	next = f.Then(func(s string) (string, error) {
+		t.Errorf("fn should not be called")
+		return s, nil
+	})
+	f.Complete("", err)
+	av, ae = next.Get(context.Background())
+	expect(t, "", av)
+	expect(t, err, ae)
+}
+
+// TestFuturePromise ensures that the Promise adapters provide the result of the Future
+func TestFuturePromise(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			f := promise.NewFuture[string](context.Background())
+			p := f.Promise()
+			pne := f.PromiseNoError()
+			f.Complete(tc.val, tc.err)
+
+			av, ae := p()
+			expect(t, tc.val, av)
+			expect(t, tc.err, ae)
+			expect(t, tc.val, pne())
+		})
+	}
+}
+
+// TestFutureState ensures that Future.State reports each State for Futures of any type
+// 1. a Future whose ctx is done reports StateCancelled without being waited on
+// 2. each State has a name
+func TestFutureState(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	pending := promise.NewFuture[string](context.Background())
+	fulfilled := promise.NewFuture[int](context.Background())
+	fulfilled.Complete(1, nil)
+	rejected := promise.NewFuture[bool](context.Background())
+	rejected.Complete(false, fmt.Errorf("some error"))
+	cancelled := promise.NewFuture[string](ctx)
+	cancel()
+
+	expected := map[promise.State]string{
+		promise.StatePending:   "Pending",
+		promise.StateFulfilled: "Fulfilled",
+		promise.StateRejected:  "Rejected",
+		promise.StateCancelled: "Cancelled",
+	}
+	for i, s := range []promise.Stater{pending, fulfilled, rejected, cancelled} {
+		state := s.State()
+		expect(t, promise.State(i), state)
+		expect(t, expected[state], state.String())
+	}
+}
+
+// TestFutureTimings ensures that Future.Timings reports the points of the lifecycle reached so far
+// 1. only Created is recorded for a pending Future that has not been waited on
+// 2. Settled is recorded once the Future is completed
+func TestFutureTimings(t *testing.T) {
+	f := promise.NewFuture[string](context.Background())
+	ts := f.Timings()
+	expect(t, false, ts.Created.IsZero())
+	expect(t, true, ts.Started.IsZero())
+	expect(t, true, ts.Waited.IsZero())
+	expect(t, true, ts.Settled.IsZero())
+
+	f.Complete("test", nil)
+	ts = f.Timings()
+	expect(t, false, ts.Settled.Before(ts.Created))
+	expect(t, true, ts.Waited.IsZero())
+}
+
+// TestFutureInit ensures expected behavior of Future.Init
+// 1. Futures declared as values may be used once prepared
+// 2. Futures prepared without being waited on do not allocate
+func TestFutureInit(t *testing.T) {
+	var fs [3]promise.Future[int]
+	for i := range fs {
+		fs[i].Init(context.Background())
+		expect(t, promise.StatePending, fs[i].State())
+	}
+	for i := range fs {
+		fs[i].Complete(i, nil)
+	}
+	for i := range fs {
+		av, ae := fs[i].Get(context.Background())
+		expect(t, i, av)
+		expect(t, nil, ae)
+		<-fs[i].Done()
+	}
+
+	// AllocsPerRun calls the func once more than runs to warm up
+	const runs = 100
+	var zs [runs + 1]promise.Future[int]
+	i := 0
+	allocs := testing.AllocsPerRun(runs, func() {
+		f := &zs[i]
+		i++
+		f.Init(context.Background())
+		f.Complete(1, nil)
+		_, _ = f.Get(context.Background())
+	})
+	expect(t, float64(0), allocs)
+}
+
+// BenchmarkFutureGetSettled measures Get on an already settled Future
+func BenchmarkFutureGetSettled(b *testing.B) {
+	f := promise.NewFuture[int](context.Background())
+	f.Complete(1, nil)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, _ = f.Get(ctx)
+		}
+	})
+}
+
+// BenchmarkPromiseSettled measures calling a Promise that has already resolved
+func BenchmarkPromiseSettled(b *testing.B) {
+	p, c := promise.You[int](context.Background())
+	c(1, nil)
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, _ = p()
+		}
+	})
+}
+
+// BenchmarkFutureState measures State on an already settled Future
+func BenchmarkFutureState(b *testing.B) {
+	f := promise.NewFuture[int](context.Background())
+	f.Complete(1, nil)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = f.State()
+	}
+}
+
+// BenchmarkFutureComplete measures creating, completing, and getting a Future
+func BenchmarkFutureComplete(b *testing.B) {
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		f := promise.NewFuture[int](ctx)
+		f.Complete(i, nil)
+		_, _ = f.Get(ctx)
+	}
+}
+
+// BenchmarkFutureInit measures preparing, completing, and getting a Future declared as a value
+func BenchmarkFutureInit(b *testing.B) {
+	ctx := context.Background()
+	fs := make([]promise.Future[int], b.N)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := range fs {
+		f := &fs[i]
+		f.Init(ctx)
+		f.Complete(i, nil)
+		_, _ = f.Get(ctx)
+	}
+}
+
+// BenchmarkFutureInitCancellable measures BenchmarkFutureInit with a Context that can be done, which must be watched
+func BenchmarkFutureInitCancellable(b *testing.B) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fs := make([]promise.Future[int], b.N)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := range fs {
+		f := &fs[i]
+		f.Init(ctx)
+		f.Complete(i, nil)
+		_, _ = f.Get(ctx)
+	}
+}
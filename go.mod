@@ -1,3 +1,3 @@
 module github.com/nabowler/promise
 
-go 1.19
+go 1.23
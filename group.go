@@ -0,0 +1,106 @@
+package promise
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+type (
+	// Group runs functions concurrently, providing a Promise for each result and
+	// collecting every error for Wait.
+	// A Group must be created with NewGroup and not be copied after first use.
+	Group struct {
+		ctx context.Context
+		sem chan struct{}
+		wg  sync.WaitGroup
+
+		mu   sync.Mutex
+		errs []error
+	}
+)
+
+// NewGroup returns a Group that will run at most limit functions at once.
+// A limit less than 1 does not limit concurrency.
+// ctx is passed to every function, and functions still waiting to run when it is done
+// will not be called.
+func NewGroup(ctx context.Context, limit int) *Group {
+	g := &Group{ctx: ctx}
+	if limit > 0 {
+		g.sem = make(chan struct{}, limit)
+	}
+	return g
+}
+
+// GroupMe returns a Promise that will provide the result of complete, run within g.
+// GroupMe will not block, even if g is at its concurrency limit.
+// If the Group's Context is done before complete, the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func GroupMe[T any](g *Group, complete func(context.Context) (T, error)) Promise[T] {
+	p, c := You[T](g.ctx)
+
+	g.wg.Add(1)
+	spawn(func() {
+		defer g.wg.Done()
+
+		var t T
+		err := g.acquire()
+		if err == nil {
+			t, err = complete(g.ctx)
+			g.release()
+		}
+		c(t, err)
+		if err != nil {
+			g.mu.Lock()
+			g.errs = append(g.errs, err)
+			g.mu.Unlock()
+		}
+	})
+
+	return p
+}
+
+// Go runs complete within g.
+// Go will not block, even if g is at its concurrency limit.
+func (g *Group) Go(complete func(context.Context) error) {
+	GroupMe(g, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, complete(ctx)
+	})
+}
+
+// Wait blocks until every function in the Group has returned, or will not be run,
+// and returns the errors of all of them joined with errors.Join.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return errors.Join(g.errs...)
+}
+
+// acquire blocks until g has capacity to run another function, or its Context is done
+func (g *Group) acquire() error {
+	if g.sem == nil {
+		if g.ctx.Err() != nil {
+			return notCompleted(g.ctx)
+		}
+		return nil
+	}
+	select {
+	case g.sem <- struct{}{}:
+		// capacity and ctx.Done() may have been ready at the same time
+		if g.ctx.Err() != nil {
+			<-g.sem
+			return notCompleted(g.ctx)
+		}
+		return nil
+	case <-g.ctx.Done():
+		return notCompleted(g.ctx)
+	}
+}
+
+func (g *Group) release() {
+	if g.sem != nil {
+		<-g.sem
+	}
+}
@@ -0,0 +1,117 @@
+package promise_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestGroup ensures expected behavior of promise.Group in the happy path
+// 1. each Promise provides the result of its function
+// 2. Wait returns a nil error when all functions succeed
+func TestGroup(t *testing.T) {
+	g := promise.NewGroup(context.Background(), 0)
+
+	var ps []promise.Promise[int]
+	for i := 0; i < 10; i++ {
+		i := i
+		ps = append(ps, promise.GroupMe(g, func(context.Context) (int, error) {
+			return i, nil
+		}))
+	}
+	var ran int32
+	g.Go(func(context.Context) error {
+		atomic.AddInt32(&ran, 1)
+		return nil
+	})
+
+	expect(t, nil, g.Wait())
+	expect(t, int32(1), atomic.LoadInt32(&ran))
+	for i, p := range ps {
+		av, ae := p()
+		expect(t, i, av)
+		expect(t, nil, ae)
+	}
+}
+
+// TestGroupErrors ensures that Group.Wait returns every error
+// 1. the Promise of a failing function provides its error
+// 2. Wait returns an error wrapping all errors
+func TestGroupErrors(t *testing.T) {
+	g := promise.NewGroup(context.Background(), 2)
+	err1 := fmt.Errorf("some error")
+	err2 := fmt.Errorf("some other error")
+
+	p := promise.GroupMe(g, func(context.Context) (string, error) {
+		return "", err1
+	})
+	g.Go(func(context.Context) error {
+		return err2
+	})
+	promise.GroupMe(g, func(context.Context) (string, error) {
+		return "test", nil
+	})
+
+	err := g.Wait()
+	expect(t, true, errors.Is(err, err1))
+	expect(t, true, errors.Is(err, err2))
+	_, ae := p()
+	expect(t, err1, ae)
+}
+
+// TestGroupLimit ensures that no more than limit functions run at once
+func TestGroupLimit(t *testing.T) {
+	const limit = 3
+	g := promise.NewGroup(context.Background(), limit)
+
+	var running, max int32
+	for i := 0; i < 20; i++ {
+		g.Go(func(context.Context) error {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&max)
+				if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return nil
+		})
+	}
+
+	expect(t, nil, g.Wait())
+	if m := atomic.LoadInt32(&max); m > limit {
+		t.Errorf("expected at most %d concurrent functions: got %d", limit, m)
+	}
+}
+
+// TestGroupCancelled ensures that functions waiting for capacity are not run when the context is done
+// 1. waiting functions are not called
+// 2. their Promises provide an error wrapping ErrNotCompleted and ctx.Err()
+func TestGroupCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	g := promise.NewGroup(ctx, 1)
+
+	release := make(chan struct{})
+	g.Go(func(context.Context) error {
+		<-release
+		return nil
+	})
+	p := promise.GroupMe(g, func(context.Context) (string, error) {
+		t.Errorf("function should not be called")
+		return "", nil
+	})
+
+	cancel()
+	_, ae := p()
+	expectNotCompleted(t, ctx, ae)
+	close(release)
+
+	expect(t, true, errors.Is(g.Wait(), promise.ErrNotCompleted))
+}
@@ -0,0 +1,109 @@
+package promise
+
+import (
+	"context"
+	"time"
+)
+
+// Hedge returns a Promise that will provide the first successful result of fns.
+// fns[0] is started immediately, and each following function is started after delay,
+// or as soon as an earlier function fails, to mitigate tail latency.
+// A delay of 0 or less starts every fn immediately, as First.
+// Each fn is passed a Context derived from ctx which is cancelled once a result is chosen,
+// so the losers can stop early.
+// If every fn fails, the default value for T and an *AggregateError holding the error of each will be returned.
+// If no fns are provided, the default value for T and ErrNoFunctions will be returned.
+// If the Context is done first, the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func Hedge[T any](ctx context.Context, delay time.Duration, fns ...func(context.Context) (T, error)) Promise[T] {
+	f := NewFuture[T](ctx)
+
+	spawn(func() {
+		t, err := hedge(ctx, delay, fns)
+		f.Complete(t, err)
+	})
+
+	return f.Promise()
+}
+
+// First returns a Promise that will provide the first successful result of fns, which are all
+// started immediately, such as speculative requests against replicas.
+// Each fn is passed a Context derived from ctx which is cancelled once a result is chosen,
+// so the losers stop consuming resources.
+// If every fn fails, the default value for T and an *AggregateError holding the error of each will be returned.
+// If no fns are provided, the default value for T and ErrNoFunctions will be returned.
+// If the Context is done first, the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func First[T any](ctx context.Context, fns ...func(context.Context) (T, error)) Promise[T] {
+	return Hedge(ctx, 0, fns...)
+}
+
+// hedge starts each of fns after delay, or once the previous fails, returning the first success
+// or an *AggregateError. Losers are cancelled.
+func hedge[T any](ctx context.Context, delay time.Duration, fns []func(context.Context) (T, error)) (T, error) {
+	var zero T
+	if len(fns) == 0 {
+		return zero, ErrNoFunctions
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// buffered so that losers can always deliver their result and exit
+	results := make(chan IndexedResult[T], len(fns))
+	launched, pending := 0, 0
+	var (
+		timer Timer
+		next  <-chan time.Time
+	)
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+	launch := func() {
+		i, fn := launched, fns[launched]
+		launched++
+		pending++
+		spawn(func() {
+			t, err := fn(ctx)
+			results <- IndexedResult[T]{i, t, err}
+		})
+
+		if timer != nil {
+			timer.Stop()
+		}
+		next = nil
+		if launched < len(fns) && delay > 0 {
+			timer = clock().NewTimer(delay)
+			next = timer.C()
+		}
+	}
+
+	launch()
+	if delay <= 0 {
+		for launched < len(fns) {
+			launch()
+		}
+	}
+	errs := make([]error, len(fns))
+	for pending > 0 {
+		select {
+		case r := <-results:
+			pending--
+			if r.Err == nil {
+				return r.Value, nil
+			}
+			errs[r.Index] = r.Err
+			if launched < len(fns) {
+				launch()
+			}
+		case <-next:
+			launch()
+		case <-ctx.Done():
+			return zero, notCompleted(ctx)
+		}
+	}
+
+	return zero, &AggregateError{Errors: errs}
+}
@@ -0,0 +1,149 @@
+package promise_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestHedge ensures expected behavior of promise.Hedge when the first function is fast
+// 1. the result of the first function is returned
+// 2. later functions are not started
+func TestHedge(t *testing.T) {
+	var started int32
+	fn := func(v string) func(context.Context) (string, error) {
+		return func(context.Context) (string, error) {
+			atomic.AddInt32(&started, 1)
+			return v, nil
+		}
+	}
+
+	p := promise.Hedge(context.Background(), time.Second, fn("first"), fn("second"))
+	for i := 0; i < 10; i++ {
+		av, ae := p()
+		expect(t, "first", av)
+		expect(t, nil, ae)
+	}
+	expect(t, int32(1), atomic.LoadInt32(&started))
+}
+
+// TestHedgeSlow ensures expected behavior of promise.Hedge when the first function is slow
+// 1. the second function is started after delay and its result is returned
+// 2. the first function's Context is cancelled
+func TestHedgeSlow(t *testing.T) {
+	cancelled := make(chan struct{})
+	slow := func(ctx context.Context) (string, error) {
+		<-ctx.Done()
+		close(cancelled)
+		return "slow", nil
+	}
+	fast := func(context.Context) (string, error) {
+		return "fast", nil
+	}
+
+	av, ae := promise.Hedge(context.Background(), 10*time.Millisecond, slow, fast)()
+	expect(t, "fast", av)
+	expect(t, nil, ae)
+	<-cancelled
+}
+
+// TestHedgeFailure ensures that promise.Hedge starts the next function as soon as one fails,
+// and returns an *AggregateError holding every error if all fail
+func TestHedgeFailure(t *testing.T) {
+	start := time.Now()
+	fail := func(n int) func(context.Context) (string, error) {
+		return func(context.Context) (string, error) {
+			return "", fmt.Errorf("error %d", n)
+		}
+	}
+
+	av, ae := promise.Hedge(context.Background(), time.Hour, fail(1), fail(2), fail(3))()
+	expect(t, "", av)
+	var agg *promise.AggregateError
+	expect(t, true, errors.As(ae, &agg))
+	expect(t, 3, len(agg.Errors))
+	for i, err := range agg.Errors {
+		expect(t, fmt.Sprintf("error %d", i+1), err.Error())
+	}
+	expect(t, "promise: all 3 functions failed; 0: error 1; 1: error 2; 2: error 3", ae.Error())
+	if time.Since(start) > time.Second {
+		t.Errorf("expected failures to start the next function without waiting for delay")
+	}
+}
+
+// TestHedgeEmpty ensures that promise.Hedge returns ErrNoFunctions when no functions are provided
+func TestHedgeEmpty(t *testing.T) {
+	_, ae := promise.Hedge[string](context.Background(), time.Millisecond)()
+	expect(t, promise.ErrNoFunctions, ae)
+}
+
+// TestHedgeCancelled ensures expected behavior of promise.Hedge when the context is done
+func TestHedgeCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	av, ae := promise.Hedge(ctx, time.Millisecond, func(ctx context.Context) (string, error) {
+		<-ctx.Done()
+		return "test", nil
+	})()
+	expect(t, "", av)
+	expectNotCompleted(t, ctx, ae)
+}
+
+// TestFirst ensures expected behavior of promise.First
+// 1. all functions are started immediately
+// 2. the first successful result is returned
+// 3. the losers' Contexts are cancelled
+func TestFirst(t *testing.T) {
+	var started int32
+	cancelled := make(chan struct{}, 2)
+	slow := func(ctx context.Context) (string, error) {
+		atomic.AddInt32(&started, 1)
+		<-ctx.Done()
+		cancelled <- struct{}{}
+		return "slow", nil
+	}
+	failing := func(context.Context) (string, error) {
+		atomic.AddInt32(&started, 1)
+		return "", fmt.Errorf("some error")
+	}
+	fast := func(context.Context) (string, error) {
+		atomic.AddInt32(&started, 1)
+		time.Sleep(10 * time.Millisecond)
+		return "fast", nil
+	}
+
+	av, ae := promise.First(context.Background(), slow, failing, slow, fast)()
+	expect(t, "fast", av)
+	expect(t, nil, ae)
+	expect(t, int32(4), atomic.LoadInt32(&started))
+	<-cancelled
+	<-cancelled
+}
+
+// TestFirstFailure ensures that promise.First returns an *AggregateError when every function fails,
+// recording each error at the index of its function
+func TestFirstFailure(t *testing.T) {
+	errSlow, errFast := errors.New("slow error"), errors.New("fast error")
+	slow := func(context.Context) (int, error) {
+		time.Sleep(10 * time.Millisecond)
+		return 0, errSlow
+	}
+	fast := func(context.Context) (int, error) {
+		return 0, errFast
+	}
+
+	av, ae := promise.First(context.Background(), slow, fast)()
+	expect(t, 0, av)
+	expect(t, true, errors.Is(ae, errSlow))
+	expect(t, true, errors.Is(ae, errFast))
+	var agg *promise.AggregateError
+	expect(t, true, errors.As(ae, &agg))
+	expect(t, errSlow, agg.Errors[0])
+	expect(t, errFast, agg.Errors[1])
+}
@@ -0,0 +1,204 @@
+package promise
+
+import (
+	"context"
+	"sync"
+)
+
+type (
+	// Pair holds the values of two joined Promises
+	Pair[A, B any] struct {
+		First  A
+		Second B
+	}
+
+	// Triple holds the values of three joined Promises
+	Triple[A, B, C any] struct {
+		First  A
+		Second B
+		Third  C
+	}
+
+	// Joiner is a unit of work for Join, created by Bind or BindFunc,
+	// that writes its value to a destination on success
+	Joiner interface {
+		join(ctx context.Context) error
+	}
+
+	joinerFunc func(ctx context.Context) error
+)
+
+// Join2 returns a Promise that will provide the values of a and b once both are resolved.
+// If either Promise returns an error, the default value for the Pair and the first error
+// will be returned without waiting for the other Promise.
+// If the Context is done first, the default value for the Pair
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func Join2[A, B any](ctx context.Context, a Promise[A], b Promise[B]) Promise[Pair[A, B]] {
+	f := NewFuture[Pair[A, B]](ctx)
+
+	var pair Pair[A, B]
+	join(f, &pair,
+		func() (err error) { pair.First, err = a(); return },
+		func() (err error) { pair.Second, err = b(); return },
+	)
+
+	return f.Promise()
+}
+
+// Join3 returns a Promise that will provide the values of a, b, and c once all are resolved.
+// If any Promise returns an error, the default value for the Triple and the first error
+// will be returned without waiting for the other Promises.
+// If the Context is done first, the default value for the Triple
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func Join3[A, B, C any](ctx context.Context, a Promise[A], b Promise[B], c Promise[C]) Promise[Triple[A, B, C]] {
+	f := NewFuture[Triple[A, B, C]](ctx)
+
+	var triple Triple[A, B, C]
+	join(f, &triple,
+		func() (err error) { triple.First, err = a(); return },
+		func() (err error) { triple.Second, err = b(); return },
+		func() (err error) { triple.Third, err = c(); return },
+	)
+
+	return f.Promise()
+}
+
+// join calls each await concurrently, completing f with *t once all have succeeded,
+// or with the first error. Each await must only write to its own field of *t.
+func join[T any](f *Future[T], t *T, awaits ...func() error) {
+	wg := sync.WaitGroup{}
+	for _, await := range awaits {
+		wg.Add(1)
+		spawn(func() {
+			defer wg.Done()
+			if err := await(); err != nil {
+				var zero T
+				f.Complete(zero, err)
+			}
+		})
+	}
+
+	spawn(func() {
+		wg.Wait()
+		f.Complete(*t, nil)
+	})
+}
+
+// Join blocks until every Joiner has succeeded, writing each value to its destination,
+// or until the first error, which is returned.
+// On the first error, the Context passed to the other Joiners is cancelled and Join waits for them
+// to return, so no destination is written after Join returns. Destinations are only written on success.
+// If ctx is done first, an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func Join(ctx context.Context, joiners ...Joiner) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		once     sync.Once
+		firstErr error
+		wg       sync.WaitGroup
+	)
+	for _, j := range joiners {
+		wg.Add(1)
+		spawn(func() {
+			defer wg.Done()
+			if err := j.join(ctx); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		})
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// Bind returns a Joiner that awaits p and writes its value to dst.
+// Cancellation only stops waiting on p; any work fulfilling it is unaffected.
+func Bind[T any](p Promise[T], dst *T) Joiner {
+	return joinerFunc(func(ctx context.Context) error {
+		t, err := p.Await(ctx)
+		if err != nil {
+			return err
+		}
+		*dst = t
+		return nil
+	})
+}
+
+// BindFunc returns a Joiner that calls fn and writes its value to dst.
+// fn is passed a Context that is cancelled if another Joiner fails.
+func BindFunc[T any](fn func(context.Context) (T, error), dst *T) Joiner {
+	return joinerFunc(func(ctx context.Context) error {
+		t, err := fn(ctx)
+		if err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return notCompleted(ctx)
+		}
+		*dst = t
+		return nil
+	})
+}
+
+func (j joinerFunc) join(ctx context.Context) error {
+	return j(ctx)
+}
+
+// MeJoin2 returns a Promise that will provide the results of a and b, run concurrently, once both succeed.
+// a and b are passed a Context derived from ctx. If either returns an error, the default value for the Pair
+// and the first error will be returned, and the Context passed to the other is cancelled so that it can
+// stop early, unless WithoutCancelPropagation is given.
+// If the Context is done first, the default value for the Pair
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func MeJoin2[A, B any](ctx context.Context, a func(context.Context) (A, error), b func(context.Context) (B, error), opts ...Option) Promise[Pair[A, B]] {
+	o := newOptions(opts)
+	f := newFuture[Pair[A, B]](ctx, o)
+	ctx = siblingContext(ctx, f, o)
+
+	var pair Pair[A, B]
+	join(f, &pair,
+		func() (err error) { pair.First, err = a(ctx); return },
+		func() (err error) { pair.Second, err = b(ctx); return },
+	)
+
+	return f.Promise()
+}
+
+// MeJoin3 returns a Promise that will provide the results of a, b, and c, run concurrently, once all succeed.
+// a, b, and c are passed a Context derived from ctx. If any returns an error, the default value for the Triple
+// and the first error will be returned, and the Context passed to the others is cancelled so that they can
+// stop early, unless WithoutCancelPropagation is given.
+// If the Context is done first, the default value for the Triple
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func MeJoin3[A, B, C any](ctx context.Context, a func(context.Context) (A, error), b func(context.Context) (B, error), c func(context.Context) (C, error), opts ...Option) Promise[Triple[A, B, C]] {
+	o := newOptions(opts)
+	f := newFuture[Triple[A, B, C]](ctx, o)
+	ctx = siblingContext(ctx, f, o)
+
+	var triple Triple[A, B, C]
+	join(f, &triple,
+		func() (err error) { triple.First, err = a(ctx); return },
+		func() (err error) { triple.Second, err = b(ctx); return },
+		func() (err error) { triple.Third, err = c(ctx); return },
+	)
+
+	return f.Promise()
+}
+
+// siblingContext returns the Context for the producers settling f: one cancelled once f is settled,
+// or ctx itself if o disables cancel propagation. Either is detached from ctx if o detaches.
+func siblingContext[T any](ctx context.Context, f *Future[T], o options) context.Context {
+	ctx = o.producerContext(ctx)
+	if o.isolate {
+		return ctx
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	f.OnComplete(func(T, error) {
+		cancel()
+	})
+	return ctx
+}
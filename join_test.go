@@ -0,0 +1,174 @@
+package promise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestJoin2 ensures expected behavior of promise.Join2 in the happy path
+// 1. the values of both Promises are returned once both are resolved
+// 2. the values continue to be returned on all calls
+func TestJoin2(t *testing.T) {
+	a := promise.Me(context.Background(), func() (string, error) {
+		time.Sleep(10 * time.Millisecond)
+		return "test", nil
+	})
+	b := promise.Resolved(42)
+
+	p := promise.Join2(context.Background(), a, b)
+	for i := 0; i < 10; i++ {
+		av, ae := p()
+		expect(t, promise.Pair[string, int]{"test", 42}, av)
+		expect(t, nil, ae)
+	}
+}
+
+// TestJoin2Error ensures that promise.Join2 returns the first error without waiting for the other Promise
+func TestJoin2Error(t *testing.T) {
+	err := fmt.Errorf("some error")
+	a, _ := promise.You[string](context.Background())
+	b := promise.Rejected[int](err)
+
+	av, ae := promise.Join2(context.Background(), a, b)()
+	expect(t, promise.Pair[string, int]{}, av)
+	expect(t, err, ae)
+}
+
+// TestJoin2Cancelled ensures expected behavior of promise.Join2 when the context is done
+func TestJoin2Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	a, _ := promise.You[string](context.Background())
+
+	av, ae := promise.Join2(ctx, a, promise.Resolved(42))()
+	expect(t, promise.Pair[string, int]{}, av)
+	expectNotCompleted(t, ctx, ae)
+}
+
+// TestJoin3 ensures expected behavior of promise.Join3
+// 1. the values of all Promises are returned once all are resolved
+// 2. the first error is returned if any Promise fails
+func TestJoin3(t *testing.T) {
+	av, ae := promise.Join3(context.Background(), promise.Resolved("test"), promise.Resolved(42), promise.Resolved(true))()
+	expect(t, promise.Triple[string, int, bool]{"test", 42, true}, av)
+	expect(t, nil, ae)
+
+	err := fmt.Errorf("some error")
+	c, _ := promise.You[bool](context.Background())
+	av, ae = promise.Join3(context.Background(), promise.Resolved("test"), promise.Rejected[int](err), c)()
+	expect(t, promise.Triple[string, int, bool]{}, av)
+	expect(t, err, ae)
+}
+
+// TestJoin ensures expected behavior of promise.Join in the happy path
+// 1. the value of every Joiner is written to its destination
+// 2. a nil error is returned
+func TestJoin(t *testing.T) {
+	var (
+		name string
+		age  int
+		ok   bool
+	)
+	err := promise.Join(context.Background(),
+		promise.Bind(promise.Resolved("test"), &name),
+		promise.Bind(promise.After(context.Background(), 10*time.Millisecond, 42), &age),
+		promise.BindFunc(func(context.Context) (bool, error) {
+			return true, nil
+		}, &ok),
+	)
+	expect(t, nil, err)
+	expect(t, "test", name)
+	expect(t, 42, age)
+	expect(t, true, ok)
+}
+
+// TestJoinError ensures expected behavior of promise.Join when a Joiner fails
+// 1. the first error is returned
+// 2. the Context passed to other Joiners is cancelled
+// 3. destinations of failed or cancelled Joiners are not written
+func TestJoinError(t *testing.T) {
+	err := fmt.Errorf("some error")
+	pending, _ := promise.You[string](context.Background())
+	name, age := "unchanged", -1
+	cancelled := false
+
+	ae := promise.Join(context.Background(),
+		promise.Bind(pending, &name),
+		promise.Bind(promise.Rejected[int](err), &age),
+		promise.BindFunc(func(ctx context.Context) (bool, error) {
+			<-ctx.Done()
+			cancelled = true
+			return true, nil
+		}, new(bool)),
+	)
+	expect(t, err, ae)
+	expect(t, "unchanged", name)
+	expect(t, -1, age)
+	expect(t, true, cancelled)
+}
+
+// TestJoinCancelled ensures that promise.Join returns an error wrapping ErrNotCompleted and ctx.Err()
+// when the context is done first
+func TestJoinCancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	pending, _ := promise.You[string](context.Background())
+
+	var name string
+	ae := promise.Join(ctx, promise.Bind(pending, &name))
+	expectNotCompleted(t, ctx, ae)
+}
+
+// TestMeJoin2 ensures expected behavior of promise.MeJoin2
+// 1. the values of both functions are returned once both succeed
+// 2. the first error is returned, and the Context of the other function is cancelled
+// 3. with WithoutCancelPropagation, the other function is left to finish
+func TestMeJoin2(t *testing.T) {
+	av, ae := promise.MeJoin2(context.Background(),
+		func(context.Context) (string, error) { return "test", nil },
+		func(context.Context) (int, error) { return 42, nil },
+	)()
+	expect(t, promise.Pair[string, int]{"test", 42}, av)
+	expect(t, nil, ae)
+
+	err := fmt.Errorf("some error")
+	cancelled := make(chan error, 1)
+	_, ae = promise.MeJoin2(context.Background(),
+		func(ctx context.Context) (string, error) {
+			<-ctx.Done()
+			cancelled <- ctx.Err()
+			return "", ctx.Err()
+		},
+		func(context.Context) (int, error) { return 0, err },
+	)()
+	expect(t, err, ae)
+	expect(t, context.Canceled, <-cancelled)
+
+	finished := make(chan error, 1)
+	_, ae = promise.MeJoin2(context.Background(),
+		func(ctx context.Context) (string, error) {
+			time.Sleep(20 * time.Millisecond)
+			finished <- ctx.Err()
+			return "test", nil
+		},
+		func(context.Context) (int, error) { return 0, err },
+		promise.WithoutCancelPropagation(),
+	)()
+	expect(t, err, ae)
+	expect(t, nil, <-finished)
+}
+
+// TestMeJoin3 ensures that promise.MeJoin3 returns the values of all functions once all succeed
+func TestMeJoin3(t *testing.T) {
+	av, ae := promise.MeJoin3(context.Background(),
+		func(context.Context) (string, error) { return "test", nil },
+		func(context.Context) (int, error) { return 42, nil },
+		func(context.Context) (bool, error) { return true, nil },
+	)()
+	expect(t, promise.Triple[string, int, bool]{"test", 42, true}, av)
+	expect(t, nil, ae)
+}
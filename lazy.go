@@ -0,0 +1,26 @@
+package promise
+
+import (
+	"context"
+	"sync"
+)
+
+// Lazy returns a Promise that will provide the result of complete.
+// Unlike Me, complete is not started until the first call to the Promise,
+// and is run at most once; all calls will return the same result.
+// ctx is passed to complete. If the Context is done before complete, the default
+// value for T and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func Lazy[T any](ctx context.Context, complete func(context.Context) (T, error)) Promise[T] {
+	p, c := You[T](ctx)
+
+	start := sync.Once{}
+	return func() (T, error) {
+		start.Do(func() {
+			spawn(func() {
+				c(complete(ctx))
+			})
+		})
+
+		return p()
+	}
+}
@@ -0,0 +1,68 @@
+package promise_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestLazy ensures expected behavior of promise.Lazy in the happy path
+// 1. complete is not called before the Promise is
+// 2. the expected value and error are returned when ctx is not done
+// 3. the expected value and error continue to be returned on all calls
+// 4. complete is only called once
+func TestLazy(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			var calls int32
+			p := promise.Lazy(context.Background(), func(context.Context) (string, error) {
+				atomic.AddInt32(&calls, 1)
+				return tc.val, tc.err
+			})
+
+			time.Sleep(10 * time.Millisecond)
+			expect(t, int32(0), atomic.LoadInt32(&calls))
+
+			for i := 0; i < 10; i++ {
+				av, ae := p()
+				expect(t, tc.val, av)
+				expect(t, tc.err, ae)
+			}
+			expect(t, int32(1), atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+// TestLazyCancelled ensures expected behavior of promise.Lazy on the non-happy path
+// when the context is done
+// 1. the default value of T and an error wrapping ErrNotCompleted and ctx.Err() are returned when ctx is done
+// 2. the default value of T and the same error continue to be returned on all calls
+func TestLazyCancelled(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		var expectedVal string
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		t.Run(name, func(t *testing.T) {
+			// block complete until the test is over so that ctx.Done() is the only ready case
+			release := make(chan struct{})
+			defer close(release)
+			p := promise.Lazy(ctx, func(ctx context.Context) (string, error) {
+				<-release
+				return tc.val, tc.err
+			})
+
+			for i := 0; i < 10; i++ {
+				av, ae := p()
+				expect(t, expectedVal, av)
+				expectNotCompleted(t, ctx, ae)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package promise
+
+import (
+	"runtime"
+	"runtime/debug"
+	"sync/atomic"
+)
+
+type (
+	// Leak describes a Promise that can never be completed: its Complete was garbage collected
+	// while the Promise was still pending.
+	Leak struct {
+		// Stack is the stack trace of the goroutine that created the Promise
+		Stack []byte
+		// Waiters is the number of callers blocked on the Promise, which will only
+		// return once its Context is done, if ever
+		Waiters int
+	}
+
+	leakDetector struct {
+		report func(Leak)
+	}
+
+	// leakHandle is kept reachable by a Complete while leak detection is enabled,
+	// so that the Complete being collected can be detected
+	leakHandle struct {
+		// finalizers are not guaranteed to run for zero-sized allocations,
+		// nor for tiny pointer-free ones, which may be batched with longer-lived allocations
+		_ *byte
+	}
+)
+
+var globalLeakDetector atomic.Pointer[leakDetector]
+
+// EnableLeakDetection tracks the Promises created by You, YouNoError, and YouErrOnly after the call,
+// calling report for each whose Complete is garbage collected while it is still pending.
+// This captures a stack trace for every Promise so is intended for debugging, not production use.
+// report is called from a runtime goroutine and should return quickly.
+func EnableLeakDetection(report func(Leak)) {
+	globalLeakDetector.Store(&leakDetector{report})
+}
+
+// DisableLeakDetection stops tracking new Promises. Promises that are already tracked may still be reported.
+func DisableLeakDetection() {
+	globalLeakDetector.Store(nil)
+}
+
+// watchLeak returns a handle that must be kept reachable by the Complete for f,
+// or nil if leak detection is disabled.
+func watchLeak[T any](f *Future[T]) *leakHandle {
+	d := globalLeakDetector.Load()
+	if d == nil {
+		return nil
+	}
+
+	h := &leakHandle{}
+	stack := debug.Stack()
+	runtime.SetFinalizer(h, func(*leakHandle) {
+		if f.State() == StatePending {
+			d.report(Leak{Stack: stack, Waiters: int(f.waiters.Load())})
+		}
+	})
+	return h
+}
+
+// keepAlive does nothing, but referencing it keeps h reachable
+func (h *leakHandle) keepAlive() {}
@@ -0,0 +1,50 @@
+package promise_test
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestLeakDetection ensures that a Promise whose Complete is garbage collected while pending is reported
+// 1. the Leak is reported with the creation stack and the number of waiters
+// 2. completed Promises are not reported
+func TestLeakDetection(t *testing.T) {
+	leaks := make(chan promise.Leak, 10)
+	promise.EnableLeakDetection(func(l promise.Leak) {
+		leaks <- l
+	})
+	defer promise.DisableLeakDetection()
+
+	completed, c := promise.You[string](context.Background())
+	c("test", nil)
+	leaked := createLeak()
+	go leaked()
+	// give the waiter time to block
+	time.Sleep(10 * time.Millisecond)
+
+	deadline := time.After(5 * time.Second)
+	for {
+		runtime.GC()
+		select {
+		case l := <-leaks:
+			expect(t, true, strings.Contains(string(l.Stack), "createLeak"))
+			expect(t, 1, l.Waiters)
+			completed()
+			return
+		case <-deadline:
+			t.Fatalf("expected a Leak to be reported")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// createLeak returns a Promise whose Complete is dropped
+func createLeak() promise.Promise[string] {
+	p, _ := promise.You[string](context.Background())
+	return p
+}
@@ -0,0 +1,100 @@
+package promise
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type (
+	// Limiter limits the rate at which work is started.
+	// Wait blocks until work may start, returning an error if ctx is done first.
+	// *rate.Limiter from golang.org/x/time/rate implements Limiter, as does TokenBucket.
+	Limiter interface {
+		Wait(ctx context.Context) error
+	}
+
+	// TokenBucket is a Limiter that allows bursts of up to burst calls, refilled at one call per interval.
+	// A TokenBucket must be created with NewTokenBucket.
+	TokenBucket struct {
+		interval time.Duration
+		burst    int
+
+		mu sync.Mutex
+		// tat is the theoretical arrival time: when the bucket will next be full
+		tat time.Time
+	}
+)
+
+// NewTokenBucket returns a full TokenBucket allowing burst calls at once and one more per interval.
+// A burst less than 1 is treated as 1.
+func NewTokenBucket(interval time.Duration, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		interval: interval,
+		burst:    burst,
+	}
+}
+
+// Wait blocks until a token is available or ctx is done, whichever happens first.
+// If ctx is done first, or its deadline is before a token would be available,
+// no token is taken and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	if ctx.Err() != nil {
+		return notCompleted(ctx)
+	}
+
+	b.mu.Lock()
+	now := clock().Now()
+	tat := b.tat
+	if tat.Before(now) {
+		tat = now
+	}
+	tat = tat.Add(b.interval)
+	wait := tat.Sub(now) - time.Duration(b.burst)*b.interval
+	if deadline, ok := ctx.Deadline(); ok && wait > 0 && deadline.Before(now.Add(wait)) {
+		b.mu.Unlock()
+		<-ctx.Done()
+		return notCompleted(ctx)
+	}
+	b.tat = tat
+	b.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	timer := clock().NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C():
+		return nil
+	case <-ctx.Done():
+		b.mu.Lock()
+		b.tat = b.tat.Add(-b.interval)
+		b.mu.Unlock()
+		return notCompleted(ctx)
+	}
+}
+
+// MeLimited returns a Promise that will provide the result of complete,
+// which is not started until limiter allows it.
+// MeLimited will not block while waiting on limiter.
+// ctx is passed to complete. If the Context is done before complete, including while waiting
+// on limiter, the default value for T and an error wrapping both ErrNotCompleted and ctx.Err()
+// will be returned.
+func MeLimited[T any](ctx context.Context, limiter Limiter, complete func(context.Context) (T, error)) Promise[T] {
+	p, c := You[T](ctx)
+
+	spawn(func() {
+		var t T
+		err := limiter.Wait(ctx)
+		if err == nil {
+			t, err = complete(ctx)
+		}
+		c(t, err)
+	})
+
+	return p
+}
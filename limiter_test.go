@@ -0,0 +1,70 @@
+package promise_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestTokenBucket ensures expected behavior of promise.TokenBucket
+// 1. burst calls are allowed immediately
+// 2. further calls wait for the bucket to refill
+// 3. calls that cannot get a token before the deadline return an error wrapping ErrNotCompleted and ctx.Err()
+func TestTokenBucket(t *testing.T) {
+	interval := 20 * time.Millisecond
+	b := promise.NewTokenBucket(interval, 2)
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		expect(t, nil, b.Wait(context.Background()))
+	}
+	if elapsed := time.Since(start); elapsed >= interval {
+		t.Errorf("expected burst to be immediate: took %v", elapsed)
+	}
+
+	expect(t, nil, b.Wait(context.Background()))
+	if elapsed := time.Since(start); elapsed < interval {
+		t.Errorf("expected to wait at least %v: took %v", interval, elapsed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	expectNotCompleted(t, ctx, b.Wait(ctx))
+}
+
+// TestMeLimited ensures expected behavior of promise.MeLimited
+// 1. complete is not started until the Limiter allows it
+// 2. the result of complete is returned
+// 3. an error wrapping ErrNotCompleted and ctx.Err() is returned when ctx is done while waiting
+func TestMeLimited(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			interval := 20 * time.Millisecond
+			b := promise.NewTokenBucket(interval, 1)
+			expect(t, nil, b.Wait(context.Background()))
+
+			start := time.Now()
+			av, ae := promise.MeLimited(context.Background(), b, func(context.Context) (string, error) {
+				if elapsed := time.Since(start); elapsed < interval/2 {
+					t.Errorf("expected complete to wait for the Limiter: started after %v", elapsed)
+				}
+				return tc.val, tc.err
+			})()
+			expect(t, tc.val, av)
+			expect(t, tc.err, ae)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			p := promise.MeLimited(ctx, b, func(context.Context) (string, error) {
+				t.Errorf("complete should not be called")
+				return tc.val, tc.err
+			})
+			cancel()
+			av, ae = p()
+			expect(t, "", av)
+			expectNotCompleted(t, ctx, ae)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package promise
+
+import (
+	"context"
+	"sync"
+	"unsafe"
+)
+
+// futureOffsets holds, by the address of its code, whether a closure is the Promise of a Future:
+// the offset of the *Future it captures, in words, or 0 for any other closure
+var futureOffsets sync.Map
+
+// StateOf reports the current State of p without blocking, as Future.State.
+// ok will be false if p was not created by this package from a Future, such as a Promise
+// returned by Resolved or written as a plain func, as its State cannot be known without calling it.
+// Promises created by Me, You, and most combinators are created from a Future.
+func StateOf[T any](p Promise[T]) (s State, ok bool) {
+	f := futureOf(p)
+	if f == nil {
+		return StatePending, false
+	}
+	return f.State(), true
+}
+
+// promise returns a new Promise for f.
+// Every Promise of a Future shares the code of this closure, which futureOf uses to recognize them,
+// so promise must not be inlined, as each inlined copy would have code of its own.
+//
+//go:noinline
+func (f *Future[T]) promise() Promise[T] {
+	return func() (T, error) {
+		return f.Get(context.Background())
+	}
+}
+
+// futureOf returns the Future that p was returned by, or nil if p was not returned by Future.Promise.
+// A func value points to its closure: the address of its code, followed by the variables it captures.
+// Nothing is recorded when a Promise is created; instead, the code of p is compared with that
+// of a Future's Promise, and if they match, the Future is read from where that closure captures it.
+func futureOf[T any](p Promise[T]) *Future[T] {
+	if p == nil {
+		return nil
+	}
+
+	c := closureOf(p)
+	code := *(*uintptr)(c)
+	v, ok := futureOffsets.Load(code)
+	if !ok {
+		v, _ = futureOffsets.LoadOrStore(code, futureOffset[T](code))
+	}
+	off := v.(int)
+	if off == 0 {
+		return nil
+	}
+	return *(**Future[T])(unsafe.Add(c, off*int(unsafe.Sizeof(uintptr(0)))))
+}
+
+// futureOffset returns the offset, in words, of the *Future captured by the Promise of a Future[T],
+// if code is the code of that Promise, or 0 otherwise
+func futureOffset[T any](code uintptr) int {
+	ref := &Future[T]{}
+	c := closureOf(ref.promise())
+	if *(*uintptr)(c) != code {
+		return 0
+	}
+	// the closure captures ref, so it is found before the end of the closure
+	for off := 1; ; off++ {
+		if *(**Future[T])(unsafe.Add(c, off*int(unsafe.Sizeof(uintptr(0))))) == ref {
+			return off
+		}
+	}
+}
+
+// closureOf returns the address of the closure fn refers to
+func closureOf[T any](fn Promise[T]) unsafe.Pointer {
+	return *(*unsafe.Pointer)(unsafe.Pointer(&fn))
+}
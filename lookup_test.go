@@ -0,0 +1,77 @@
+package promise_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+// TestStateOf ensures expected behavior of promise.StateOf
+// 1. Promises created from a Future report the State of the Future without blocking
+// 2. every call to Future.Promise returns a Promise that reports the same State
+// 3. Promises not created from a Future are not reported
+func TestStateOf(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pending, _ := promise.You[int](ctx)
+	fulfilled, cf := promise.You[int](ctx)
+	cf(1, nil)
+	rejected := promise.Me(ctx, func() (int, error) {
+		return 0, errors.New("rejected")
+	})
+	_, _ = rejected()
+	cancelledCtx, cancelCancelled := context.WithCancel(ctx)
+	cancelled, _ := promise.You[int](cancelledCtx)
+	cancelCancelled()
+
+	for i, p := range []promise.Promise[int]{pending, fulfilled, rejected, cancelled} {
+		s, ok := promise.StateOf(p)
+		expect(t, true, ok)
+		expect(t, promise.State(i), s)
+	}
+
+	f := promise.NewFuture[int](ctx)
+	f.Complete(1, nil)
+	s, ok := promise.StateOf(f.Promise())
+	expect(t, true, ok)
+	expect(t, promise.StateFulfilled, s)
+
+	_, ok = promise.StateOf(promise.Resolved(1))
+	expect(t, false, ok)
+	_, ok = promise.StateOf[int](nil)
+	expect(t, false, ok)
+}
+
+// TestStateOfShapes ensures that promise.StateOf recognizes the Promises of Futures of every kind of type,
+// and no other funcs, even those of the same type that wrap a Future
+func TestStateOfShapes(t *testing.T) {
+	type pair struct{ a, b int }
+
+	expectFulfilled(t, 1)
+	expectFulfilled(t, "test")
+	expectFulfilled(t, pair{1, 2})
+	expectFulfilled(t, &pair{1, 2})
+	expectFulfilled[any](t, 1)
+	expectFulfilled(t, []byte("test"))
+
+	f := promise.NewFuture[int](context.Background())
+	f.Complete(1, nil)
+	wrapped := promise.Promise[int](func() (int, error) {
+		return f.Get(context.Background())
+	})
+	_, ok := promise.StateOf(wrapped)
+	expect(t, false, ok)
+}
+
+// expectFulfilled expects StateOf to report a Promise fulfilled with v as StateFulfilled
+func expectFulfilled[T any](t *testing.T, v T) {
+	t.Helper()
+	p, c := promise.You[T](context.Background())
+	c(v, nil)
+	s, ok := promise.StateOf(p)
+	expect(t, true, ok)
+	expect(t, promise.StateFulfilled, s)
+}
@@ -0,0 +1,62 @@
+package promise
+
+import (
+	"context"
+	"sync"
+)
+
+type (
+	// Map deduplicates concurrent work by key, in the style of singleflight.
+	// While a Promise for a key is in flight, GetOrCreate will return it rather than starting
+	// another; once it is resolved the key is forgotten and the next call will start new work.
+	// The zero value is ready to use. A Map must not be copied after first use.
+	Map[K comparable, V any] struct {
+		mu sync.Mutex
+		m  map[K]*Future[V]
+	}
+)
+
+// GetOrCreate returns the in-flight Promise for key, or starts complete and returns a Promise for its result.
+// ctx is passed to complete and governs the Promise for every caller sharing it.
+// If the Context is done before complete, the default value for V
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func (m *Map[K, V]) GetOrCreate(ctx context.Context, key K, complete func(context.Context) (V, error)) Promise[V] {
+	m.mu.Lock()
+	if f, ok := m.m[key]; ok {
+		m.mu.Unlock()
+		return f.Promise()
+	}
+
+	f := NewFuture[V](ctx)
+	if m.m == nil {
+		m.m = make(map[K]*Future[V])
+	}
+	m.m[key] = f
+	m.mu.Unlock()
+
+	f.OnComplete(func(V, error) {
+		m.forget(key, f)
+	})
+	spawn(func() {
+		f.Complete(complete(ctx))
+	})
+
+	return f.Promise()
+}
+
+// Forget removes key from the Map, so that the next call to GetOrCreate will start new work.
+// Callers already holding the Promise for key are unaffected.
+func (m *Map[K, V]) Forget(key K) {
+	m.mu.Lock()
+	delete(m.m, key)
+	m.mu.Unlock()
+}
+
+// forget removes key only if it still refers to f
+func (m *Map[K, V]) forget(key K, f *Future[V]) {
+	m.mu.Lock()
+	if m.m[key] == f {
+		delete(m.m, key)
+	}
+	m.mu.Unlock()
+}
@@ -0,0 +1,91 @@
+package promise_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+// TestMap ensures expected behavior of promise.Map
+// 1. concurrent calls for the same key share a single call to complete
+// 2. every caller receives the same result
+// 3. calls for different keys do not share work
+func TestMap(t *testing.T) {
+	var m promise.Map[string, int]
+	var calls int32
+	release := make(chan struct{})
+	complete := func(context.Context) (int, error) {
+		<-release
+		return int(atomic.AddInt32(&calls, 1)), nil
+	}
+
+	var ps []promise.Promise[int]
+	for i := 0; i < 10; i++ {
+		ps = append(ps, m.GetOrCreate(context.Background(), "a", complete))
+	}
+	other := m.GetOrCreate(context.Background(), "b", complete)
+	close(release)
+
+	first, ae := ps[0]()
+	expect(t, nil, ae)
+	for _, p := range ps {
+		av, ae := p()
+		expect(t, first, av)
+		expect(t, nil, ae)
+	}
+	ov, _ := other()
+	expect(t, true, ov != first)
+	expect(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+// TestMapSettledIsForgotten ensures that a key is forgotten once its Promise is resolved
+// 1. a call after resolution starts new work
+// 2. Promises from before are unaffected
+func TestMapSettledIsForgotten(t *testing.T) {
+	var m promise.Map[string, int]
+	var calls int32
+	complete := func(context.Context) (int, error) {
+		return int(atomic.AddInt32(&calls, 1)), nil
+	}
+
+	settled := make(chan struct{})
+	p := m.GetOrCreate(context.Background(), "a", complete)
+	promise.OnComplete(p, func(int, error) { close(settled) })
+	<-settled
+
+	// the key is forgotten by a callback, so retry until new work is started
+	var p2 promise.Promise[int]
+	for atomic.LoadInt32(&calls) < 2 {
+		p2 = m.GetOrCreate(context.Background(), "a", complete)
+		p2()
+	}
+
+	av, _ := p()
+	expect(t, 1, av)
+	av, _ = p2()
+	expect(t, 2, av)
+}
+
+// TestMapForget ensures that Map.Forget causes the next call to start new work
+func TestMapForget(t *testing.T) {
+	var m promise.Map[string, int]
+	release := make(chan struct{})
+	defer close(release)
+
+	wg := sync.WaitGroup{}
+	complete := func(context.Context) (int, error) {
+		wg.Done()
+		<-release
+		return 0, nil
+	}
+
+	// Wait will only return if complete is called twice
+	wg.Add(2)
+	m.GetOrCreate(context.Background(), "a", complete)
+	m.Forget("a")
+	m.GetOrCreate(context.Background(), "a", complete)
+	wg.Wait()
+}
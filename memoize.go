@@ -0,0 +1,33 @@
+package promise
+
+import (
+	"context"
+	"sync"
+)
+
+// Memoize returns a function that provides a Promise for the result of fn for each key.
+// The first call for a key starts fn, and every call for that key, concurrent or later,
+// shares the same Promise and its eventual result, including errors.
+// Unlike Map, results are kept for the life of the returned function.
+// ctx is passed to every fn. If the Context is done before fn, the default value for V
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func Memoize[K comparable, V any](ctx context.Context, fn func(context.Context, K) (V, error)) func(K) Promise[V] {
+	var (
+		mu       sync.Mutex
+		promises = make(map[K]Promise[V])
+	)
+
+	return func(key K) Promise[V] {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if p, ok := promises[key]; ok {
+			return p
+		}
+		p := Me(ctx, func() (V, error) {
+			return fn(ctx, key)
+		})
+		promises[key] = p
+		return p
+	}
+}
@@ -0,0 +1,48 @@
+package promise_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+// TestMemoize ensures expected behavior of promise.Memoize
+// 1. fn is called once per key, regardless of concurrent or later calls
+// 2. every call for a key receives the same result
+// 3. errors are shared like values
+func TestMemoize(t *testing.T) {
+	var calls int32
+	get := promise.Memoize(context.Background(), func(ctx context.Context, key int) (string, error) {
+		atomic.AddInt32(&calls, 1)
+		if key < 0 {
+			return "", fmt.Errorf("negative key %d", key)
+		}
+		return strconv.Itoa(key), nil
+	})
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		for _, key := range []int{1, 2, -1} {
+			wg.Add(1)
+			go func(key int) {
+				defer wg.Done()
+				av, ae := get(key)()
+				if key < 0 {
+					expect(t, "negative key -1", ae.Error())
+					return
+				}
+				expect(t, strconv.Itoa(key), av)
+				expect(t, nil, ae)
+			}(key)
+		}
+	}
+	wg.Wait()
+
+	get(1)()
+	expect(t, int32(3), atomic.LoadInt32(&calls))
+}
@@ -0,0 +1,83 @@
+package promise
+
+import "context"
+
+type (
+	// Promise2 is a blocking function that will return the same (A, B, error) on every call
+	Promise2[A, B any] func() (A, B, error)
+
+	// Complete2 is a non-blocking function that will fulfill a Promise created by You2
+	Complete2[A, B any] func(A, B, error)
+
+	// Promise3 is a blocking function that will return the same (A, B, C, error) on every call
+	Promise3[A, B, C any] func() (A, B, C, error)
+
+	// Complete3 is a non-blocking function that will fulfill a Promise created by You3
+	Complete3[A, B, C any] func(A, B, C, error)
+)
+
+// Me2 returns a Promise2 that will provide the result of complete.
+// If the Context is done before complete, the default values for A and B
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func Me2[A, B any](ctx context.Context, complete func() (A, B, error)) Promise2[A, B] {
+	p, c := You2[A, B](ctx)
+
+	spawn(func() {
+		c(complete())
+	})
+
+	return p
+}
+
+// You2 returns a Promise2 and a Completion.
+// The Promise will block until Complete is called.
+// The first call to Complete will set the return values for the Promise.
+// Subsequent calls to Complete will no-op.
+// If the Context is done before Complete, the default values for A and B
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func You2[A, B any](ctx context.Context) (Promise2[A, B], Complete2[A, B]) {
+	f := NewFuture[Pair[A, B]](ctx)
+
+	p := func() (A, B, error) {
+		pair, err := f.Get(context.Background())
+		return pair.First, pair.Second, err
+	}
+	complete := func(a A, b B, err error) {
+		f.Complete(Pair[A, B]{a, b}, err)
+	}
+
+	return p, complete
+}
+
+// Me3 returns a Promise3 that will provide the result of complete.
+// If the Context is done before complete, the default values for A, B, and C
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func Me3[A, B, C any](ctx context.Context, complete func() (A, B, C, error)) Promise3[A, B, C] {
+	p, c := You3[A, B, C](ctx)
+
+	spawn(func() {
+		c(complete())
+	})
+
+	return p
+}
+
+// You3 returns a Promise3 and a Completion.
+// The Promise will block until Complete is called.
+// The first call to Complete will set the return values for the Promise.
+// Subsequent calls to Complete will no-op.
+// If the Context is done before Complete, the default values for A, B, and C
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func You3[A, B, C any](ctx context.Context) (Promise3[A, B, C], Complete3[A, B, C]) {
+	f := NewFuture[Triple[A, B, C]](ctx)
+
+	p := func() (A, B, C, error) {
+		triple, err := f.Get(context.Background())
+		return triple.First, triple.Second, triple.Third, err
+	}
+	complete := func(a A, b B, c C, err error) {
+		f.Complete(Triple[A, B, C]{a, b, c}, err)
+	}
+
+	return p, complete
+}
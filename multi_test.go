@@ -0,0 +1,75 @@
+package promise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+// TestMe2 ensures expected behavior of promise.Me2
+// 1. the expected values and error are returned when ctx is not done
+// 2. the expected values and error continue to be returned on all calls
+func TestMe2(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			p := promise.Me2(context.Background(), func() (string, int, error) {
+				return tc.val, len(tc.val), tc.err
+			})
+			for i := 0; i < 10; i++ {
+				a, b, ae := p()
+				expect(t, tc.val, a)
+				expect(t, len(tc.val), b)
+				expect(t, tc.err, ae)
+			}
+		})
+	}
+}
+
+// TestYou2 ensures expected behavior of promise.You2
+// 1. the completed values and error are returned
+// 2. subsequent calls to Complete do not change the returned values
+// 3. the default values and an error wrapping ErrNotCompleted are returned when ctx is done
+func TestYou2(t *testing.T) {
+	p, c := promise.You2[string, int](context.Background())
+	c("test", 4, nil)
+	c("invalid", 0, fmt.Errorf("invalid error"))
+	a, b, ae := p()
+	expect(t, "test", a)
+	expect(t, 4, b)
+	expect(t, nil, ae)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	p, _ = promise.You2[string, int](ctx)
+	a, b, ae = p()
+	expect(t, "", a)
+	expect(t, 0, b)
+	expectNotCompleted(t, ctx, ae)
+}
+
+// TestMe3 ensures expected behavior of promise.Me3 and promise.You3
+// 1. the expected values and error are returned
+// 2. the default values and an error wrapping ErrNotCompleted are returned when ctx is done
+func TestMe3(t *testing.T) {
+	err := fmt.Errorf("some error")
+	p := promise.Me3(context.Background(), func() (string, int, bool, error) {
+		return "test", 4, true, err
+	})
+	a, b, c, ae := p()
+	expect(t, "test", a)
+	expect(t, 4, b)
+	expect(t, true, c)
+	expect(t, err, ae)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	p, _ = promise.You3[string, int, bool](ctx)
+	a, b, c, ae = p()
+	expect(t, "", a)
+	expect(t, 0, b)
+	expect(t, false, c)
+	expectNotCompleted(t, ctx, ae)
+}
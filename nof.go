@@ -0,0 +1,60 @@
+package promise
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// NOf returns a Promise that will provide the values of the first n of ps to succeed,
+// in the order they succeeded, without waiting for the rest, such as for a quorum read.
+// Once so many have failed that n can no longer succeed, the default value for []T
+// and an error wrapping ErrNotEnough and the errors of the failed Promises will be returned.
+// An n less than 1 provides an empty slice immediately.
+// If the Context is done first, the default value for []T
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func NOf[T any](ctx context.Context, n int, ps ...Promise[T]) Promise[[]T] {
+	if n < 1 {
+		return Resolved([]T{})
+	}
+	f := NewFuture[[]T](ctx)
+
+	spawn(func() {
+		results := make(chan Result[T], len(ps))
+		for _, p := range ps {
+			spawn(func() {
+				t, err := p.Await(ctx)
+				results <- Result[T]{t, err}
+			})
+		}
+
+		var (
+			vals []T
+			errs []error
+		)
+		for range ps {
+			if len(vals) >= n || len(ps)-len(errs) < n {
+				break
+			}
+			r := <-results
+			if r.Err != nil {
+				errs = append(errs, r.Err)
+				continue
+			}
+			vals = append(vals, r.Value)
+		}
+
+		if len(vals) >= n {
+			f.Complete(vals, nil)
+			return
+		}
+		if len(errs) == 0 {
+			// n is more than len(ps)
+			f.Complete(nil, ErrNotEnough)
+			return
+		}
+		f.Complete(nil, fmt.Errorf("%w: %w", ErrNotEnough, errors.Join(errs...)))
+	})
+
+	return f.Promise()
+}
@@ -0,0 +1,65 @@
+package promise_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestNOf ensures expected behavior of promise.NOf in the happy path
+// 1. the values of the first n Promises to succeed are returned, in the order they succeeded
+// 2. the remaining Promises are not waited for
+// 3. an n less than 1 returns an empty slice
+func TestNOf(t *testing.T) {
+	pending, _ := promise.You[int](context.Background())
+	av, ae := promise.NOf(context.Background(), 2,
+		promise.After(context.Background(), 20*time.Millisecond, 1),
+		pending,
+		promise.Rejected[int](fmt.Errorf("some error")),
+		promise.Resolved(2),
+		promise.After(context.Background(), 5*time.Millisecond, 3),
+	)()
+	expect(t, nil, ae)
+	expect(t, 2, len(av))
+	expect(t, 2, av[0])
+	expect(t, 3, av[1])
+
+	av, ae = promise.NOf[int](context.Background(), 0, pending)()
+	expect(t, nil, ae)
+	expect(t, 0, len(av))
+}
+
+// TestNOfNotEnough ensures that promise.NOf returns an error wrapping ErrNotEnough
+// and the errors of the failed Promises once n can no longer succeed
+func TestNOfNotEnough(t *testing.T) {
+	err := fmt.Errorf("some error")
+	pending, _ := promise.You[int](context.Background())
+	av, ae := promise.NOf(context.Background(), 3,
+		promise.Resolved(1),
+		promise.Rejected[int](err),
+		promise.Rejected[int](err),
+		pending,
+	)()
+	expect(t, true, av == nil)
+	expect(t, true, errors.Is(ae, promise.ErrNotEnough))
+	expect(t, true, errors.Is(ae, err))
+
+	_, ae = promise.NOf(context.Background(), 3, promise.Resolved(1))()
+	expect(t, promise.ErrNotEnough, ae)
+}
+
+// TestNOfCancelled ensures that promise.NOf returns an error wrapping ErrNotCompleted and ctx.Err()
+// when the context is done first
+func TestNOfCancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	pending, _ := promise.You[int](context.Background())
+
+	av, ae := promise.NOf(ctx, 1, pending)()
+	expect(t, true, av == nil)
+	expectNotCompleted(t, ctx, ae)
+}
@@ -0,0 +1,58 @@
+package promise
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+type (
+	// Observer is notified of the lifecycle of every Future, and so every Promise,
+	// created while it is set with SetObserver. Observers are called synchronously
+	// and must be safe for concurrent use; they should return quickly.
+	// Embed NopObserver to only implement the callbacks of interest.
+	Observer interface {
+		// Created is called when a Future is created
+		Created()
+		// Settled is called when a Future is completed, with the time since it was created
+		// and the error it was completed with
+		Settled(d time.Duration, err error)
+		// Cancelled is called when a Future's Context is done before it was completed,
+		// with the time since it was created and the resulting error
+		Cancelled(d time.Duration, err error)
+		// PanicRecovered is called when a panicking producer is recovered, with the value passed to panic
+		PanicRecovered(v any)
+	}
+
+	// NopObserver implements Observer with callbacks that do nothing
+	NopObserver struct{}
+
+	observerHolder struct {
+		Observer
+	}
+)
+
+var globalObserver atomic.Pointer[observerHolder]
+
+// SetObserver sets the Observer for all Futures created after the call.
+// Futures that already exist keep the Observer they were created with.
+// A nil Observer disables observation.
+func SetObserver(o Observer) {
+	if o == nil {
+		globalObserver.Store(nil)
+		return
+	}
+	globalObserver.Store(&observerHolder{o})
+}
+
+// currentObserver returns the currently set Observer, or nil
+func currentObserver() Observer {
+	if h := globalObserver.Load(); h != nil {
+		return h.Observer
+	}
+	return nil
+}
+
+func (NopObserver) Created()                       {}
+func (NopObserver) Settled(time.Duration, error)   {}
+func (NopObserver) Cancelled(time.Duration, error) {}
+func (NopObserver) PanicRecovered(any)             {}
@@ -0,0 +1,77 @@
+package promise_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+type countingObserver struct {
+	promise.NopObserver
+	created, settled, failed, cancelled, panicked atomic.Int32
+}
+
+func (o *countingObserver) Created() {
+	o.created.Add(1)
+}
+
+func (o *countingObserver) Settled(d time.Duration, err error) {
+	o.settled.Add(1)
+	if err != nil {
+		o.failed.Add(1)
+	}
+}
+
+func (o *countingObserver) Cancelled(d time.Duration, err error) {
+	o.cancelled.Add(1)
+}
+
+func (o *countingObserver) PanicRecovered(any) {
+	o.panicked.Add(1)
+}
+
+// TestObserver ensures that the Observer set by promise.SetObserver is notified
+// 1. Created is called for every Promise
+// 2. Settled is called for every completed Promise, with its error
+// 3. Cancelled is called for every Promise whose ctx is done first
+// 4. PanicRecovered is called for every recovered panic
+// 5. Promises created after removing the Observer are not observed
+func TestObserver(t *testing.T) {
+	o := &countingObserver{}
+	promise.SetObserver(o)
+	defer promise.SetObserver(nil)
+
+	promise.Me(context.Background(), func() (string, error) {
+		return "test", nil
+	})()
+	promise.Me(context.Background(), func() (string, error) {
+		return "", fmt.Errorf("some error")
+	})()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	p, _ := promise.You[string](ctx)
+	p()
+
+	p, c := promise.You[string](context.Background())
+	promise.CompleteWith(c, func() (string, error) {
+		panic("oops")
+	})
+	p()
+
+	promise.SetObserver(nil)
+	promise.Resolved("test")()
+	promise.Me(context.Background(), func() (string, error) {
+		return "test", nil
+	})()
+
+	expect(t, int32(4), o.created.Load())
+	expect(t, int32(3), o.settled.Load())
+	expect(t, int32(2), o.failed.Load())
+	expect(t, int32(1), o.cancelled.Load())
+	expect(t, int32(1), o.panicked.Load())
+}
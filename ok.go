@@ -0,0 +1,61 @@
+package promise
+
+import "context"
+
+type (
+	// PromiseOk is a blocking function that will return the same (T, bool) on every call.
+	// ok is false if the value was never set, distinguishing it from a set default value for T.
+	PromiseOk[T any] func() (T, bool)
+
+	// CompleteOk is a non-blocking function that will fulfill a Promise created by YouOk
+	CompleteOk[T any] func(T)
+)
+
+// MeOk returns a PromiseOk that will provide the result of complete.
+// If the Context is done before complete, the default value for T and false will be returned.
+// opts may be used to further configure the Promise.
+func MeOk[T any](ctx context.Context, complete func() T, opts ...Option) PromiseOk[T] {
+	p := Me(ctx, func() (T, error) {
+		return complete(), nil
+	}, opts...)
+
+	return func() (T, bool) {
+		t, err := p()
+		return t, err == nil
+	}
+}
+
+// YouOk returns a PromiseOk and a Completion.
+// The Promise will block until Complete is called.
+// The first call to Complete will set the return value for the Promise.
+// Subsequent calls to Complete will no-op.
+// If the Context is done before Complete, the default value for T and false will be returned.
+// opts may be used to further configure the Promise.
+func YouOk[T any](ctx context.Context, opts ...Option) (PromiseOk[T], CompleteOk[T]) {
+	p, c := You[T](ctx, opts...)
+
+	promise := func() (T, bool) {
+		t, err := p()
+		return t, err == nil
+	}
+	complete := func(t T) {
+		c(t, nil)
+	}
+
+	return promise, complete
+}
+
+// Await blocks until the Promise is resolved or ctx is done, whichever happens first.
+// If ctx is done first, the default value for T and false will be returned.
+// ctx only governs this call: the Promise, and any work fulfilling it, is unaffected
+// and other callers will continue to receive its eventual result.
+func (p PromiseOk[T]) Await(ctx context.Context) (T, bool) {
+	t, err := Promise[T](func() (T, error) {
+		t, ok := p()
+		if !ok {
+			return t, ErrNotCompleted
+		}
+		return t, nil
+	}).Await(ctx)
+	return t, err == nil
+}
@@ -0,0 +1,74 @@
+package promise_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestMeOk ensures expected behavior of promise.MeOk
+// 1. the value and true are returned, even for the default value of T
+// 2. the default value and false are returned when ctx is done first
+func TestMeOk(t *testing.T) {
+	for _, val := range []int{0, 1} {
+		p := promise.MeOk(context.Background(), func() int {
+			return val
+		})
+		for i := 0; i < 10; i++ {
+			av, ok := p()
+			expect(t, val, av)
+			expect(t, true, ok)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	release := make(chan struct{})
+	defer close(release)
+	p := promise.MeOk(ctx, func() int {
+		<-release
+		return 1
+	})
+	cancel()
+	av, ok := p()
+	expect(t, 0, av)
+	expect(t, false, ok)
+}
+
+// TestYouOk ensures expected behavior of promise.YouOk
+// 1. the completed value and true are returned
+// 2. subsequent calls to Complete do not change the returned value
+// 3. the default value and false are returned when ctx is done first
+func TestYouOk(t *testing.T) {
+	p, c := promise.YouOk[string](context.Background())
+	c("")
+	c("invalid")
+	av, ok := p()
+	expect(t, "", av)
+	expect(t, true, ok)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p, c = promise.YouOk[string](ctx)
+	cancel()
+	c("too late")
+	av, ok = p()
+	expect(t, "", av)
+	expect(t, false, ok)
+}
+
+// TestPromiseOkAwait ensures that PromiseOk.Await returns false when the await ctx is done first
+func TestPromiseOkAwait(t *testing.T) {
+	p, c := promise.YouOk[string](context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	av, ok := p.Await(ctx)
+	expect(t, "", av)
+	expect(t, false, ok)
+
+	c("test")
+	av, ok = p.Await(context.Background())
+	expect(t, "test", av)
+	expect(t, true, ok)
+}
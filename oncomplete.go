@@ -0,0 +1,17 @@
+package promise
+
+// OnComplete calls fn with the result of p once p is resolved.
+// Each call to OnComplete will call fn exactly once, in its own goroutine.
+// If p was created from a Future, as by Me, You, and most combinators, fn is registered
+// with Future.OnComplete so no goroutine is started until p is resolved.
+// Otherwise, p can only be observed by calling it, so a goroutine will block on p.
+func OnComplete[T any](p Promise[T], fn func(T, error)) {
+	if f := futureOf(p); f != nil {
+		f.OnComplete(fn)
+		return
+	}
+
+	spawn(func() {
+		fn(p())
+	})
+}
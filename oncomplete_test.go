@@ -0,0 +1,93 @@
+package promise_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/nabowler/promise"
+	"github.com/nabowler/promise/promisetest"
+)
+
+// TestOnComplete ensures expected behavior of promise.OnComplete
+// 1. every registered fn is called with the result of the Promise
+// 2. every registered fn is called exactly once
+func TestOnComplete(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			p, c := promise.You[string](context.Background())
+
+			var calls int32
+			wg := sync.WaitGroup{}
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				promise.OnComplete(p, func(av string, ae error) {
+					defer wg.Done()
+					atomic.AddInt32(&calls, 1)
+					expect(t, tc.val, av)
+					expect(t, tc.err, ae)
+				})
+			}
+
+			c(tc.val, tc.err)
+			wg.Wait()
+			expect(t, int32(10), atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+// TestFutureOnComplete ensures expected behavior of Future.OnComplete
+// 1. fns registered before the Future is settled are called with its result
+// 2. fns registered after the Future is settled are called with its result
+// 3. every registered fn is called exactly once
+func TestFutureOnComplete(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			f := promise.NewFuture[string](context.Background())
+
+			var calls int32
+			wg := sync.WaitGroup{}
+			register := func() {
+				wg.Add(1)
+				f.OnComplete(func(av string, ae error) {
+					defer wg.Done()
+					atomic.AddInt32(&calls, 1)
+					expect(t, tc.val, av)
+					expect(t, tc.err, ae)
+				})
+			}
+
+			for i := 0; i < 5; i++ {
+				register()
+			}
+			f.Complete(tc.val, tc.err)
+			for i := 0; i < 5; i++ {
+				register()
+			}
+
+			wg.Wait()
+			expect(t, int32(10), atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+// TestOnCompleteNoGoroutine ensures that promise.OnComplete does not start a goroutine
+// for a Promise created from a Future until the Promise is resolved
+func TestOnCompleteNoGoroutine(t *testing.T) {
+	s := promisetest.NewScheduler(t)
+	p, c := promise.You[string](context.Background())
+
+	var calls atomic.Int32
+	promise.OnComplete(p, func(string, error) {
+		calls.Add(1)
+	})
+	expect(t, 0, s.Pending())
+
+	c("test", nil)
+	expect(t, 1, s.Pending())
+	s.RunUntilIdle()
+	expect(t, int32(1), calls.Load())
+}
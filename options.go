@@ -0,0 +1,108 @@
+package promise
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// Option configures a Promise created by Me, MeNoError, You, YouNoError, or NewFuture
+	Option func(*options)
+
+	options struct {
+		recover  bool
+		timeout  time.Duration
+		observer Observer
+		timings  func(Timings)
+		policy   FailurePolicy
+		detach   bool
+		// isolate disables cancelling sibling producers when one fails
+		isolate bool
+		label   string
+	}
+)
+
+// WithRecover converts a panic in the producer passed to Me or MeNoError into a *PanicError,
+// rather than crashing the program. It has no effect on You, YouNoError, or NewFuture,
+// which do not run a producer.
+func WithRecover() Option {
+	return func(o *options) {
+		o.recover = true
+	}
+}
+
+// WithTimeout settles the Promise with the default value for T and ErrTimeout
+// if it has not been completed within d.
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.timeout = d
+	}
+}
+
+// WithObserver sets the Observer notified of the Promise's lifecycle,
+// in place of the Observer set with SetObserver.
+func WithObserver(obs Observer) Option {
+	return func(o *options) {
+		o.observer = obs
+	}
+}
+
+// WithTimings calls fn with the Timings of the Promise once it is settled,
+// for measuring producer latency separately from how long callers waited.
+// fn is called in its own goroutine.
+func WithTimings(fn func(Timings)) Option {
+	return func(o *options) {
+		o.timings = fn
+	}
+}
+
+// WithDetach detaches the Promise from the cancellation of its Context, as with context.WithoutCancel,
+// so that it is only settled by its producer and a result remains available to later callers
+// even after the Context is done. Values of the Context are preserved.
+// The Context passed to producers, such as those of ForEach, MeJoin2, and ScopeMe, is detached as well.
+// Callers that should stop waiting when the Context is done can use Await with it.
+func WithDetach() Option {
+	return func(o *options) {
+		o.detach = true
+	}
+}
+
+// WithoutCancelPropagation stops combinators that run several producers, such as ForEach and MeJoin2,
+// from cancelling the Context passed to the other producers when one fails,
+// so that they run to completion. It has no effect on other constructors.
+func WithoutCancelPropagation() Option {
+	return func(o *options) {
+		o.isolate = true
+	}
+}
+
+// WithFailurePolicy sets how ForEach responds to a failing call.
+// It has no effect on other constructors.
+func WithFailurePolicy(p FailurePolicy) Option {
+	return func(o *options) {
+		o.policy = p
+	}
+}
+
+func newOptions(opts []Option) options {
+	if len(opts) == 0 {
+		// avoid allocating for the common case, as applying an Option makes o escape
+		return options{observer: currentObserver()}
+	}
+	o := &options{
+		observer: currentObserver(),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return *o
+}
+
+// producerContext returns the Context to pass to producers: ctx, detached from its cancellation
+// as with context.WithoutCancel if o detaches, so that producers are not cancelled before the Promise could be
+func (o options) producerContext(ctx context.Context) context.Context {
+	if o.detach {
+		return context.WithoutCancel(ctx)
+	}
+	return ctx
+}
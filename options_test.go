@@ -0,0 +1,168 @@
+package promise_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestWithRecover ensures that promise.WithRecover converts a panicking producer into a *PanicError
+// 1. Me provides the default value of T and a *PanicError
+// 2. MeNoError provides the default value of T
+func TestWithRecover(t *testing.T) {
+	p := promise.Me(context.Background(), func() (string, error) {
+		panic("oops")
+	}, promise.WithRecover())
+	av, ae := p()
+	expect(t, "", av)
+	var pe *promise.PanicError
+	expect(t, true, errors.As(ae, &pe))
+	expect(t, "oops", pe.Value)
+
+	pne := promise.MeNoError(context.Background(), func() string {
+		panic("oops")
+	}, promise.WithRecover())
+	expect(t, "", pne())
+}
+
+// TestWithTimeout ensures that promise.WithTimeout settles a Promise that is not completed in time
+// 1. the default value of T and ErrTimeout are returned
+// 2. a Promise completed in time is unaffected
+func TestWithTimeout(t *testing.T) {
+	p, _ := promise.You[string](context.Background(), promise.WithTimeout(10*time.Millisecond))
+	av, ae := p()
+	expect(t, "", av)
+	expect(t, promise.ErrTimeout, ae)
+
+	p = promise.Me(context.Background(), func() (string, error) {
+		return "test", nil
+	}, promise.WithTimeout(time.Second))
+	av, ae = p()
+	expect(t, "test", av)
+	expect(t, nil, ae)
+}
+
+// TestWithObserver ensures that promise.WithObserver is used in place of the global Observer
+func TestWithObserver(t *testing.T) {
+	global := &countingObserver{}
+	promise.SetObserver(global)
+	defer promise.SetObserver(nil)
+
+	o := &countingObserver{}
+	promise.Me(context.Background(), func() (string, error) {
+		panic("oops")
+	}, promise.WithObserver(o), promise.WithRecover())()
+
+	f := promise.NewFuture[string](context.Background(), promise.WithObserver(o))
+	f.Complete("test", nil)
+
+	expect(t, int32(2), o.created.Load())
+	expect(t, int32(2), o.settled.Load())
+	expect(t, int32(1), o.failed.Load())
+	expect(t, int32(1), o.panicked.Load())
+	expect(t, int32(0), global.created.Load())
+}
+
+// TestWithTimings ensures that promise.WithTimings provides the Timings of a settled Promise
+// 1. each point of the lifecycle is recorded, in order
+// 2. fn is called once the Promise is settled
+func TestWithTimings(t *testing.T) {
+	timings := make(chan promise.Timings, 1)
+	release := make(chan struct{})
+	p := promise.Me(context.Background(), func() (string, error) {
+		<-release
+		return "test", nil
+	}, promise.WithTimings(func(ts promise.Timings) {
+		timings <- ts
+	}))
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+	}()
+	av, ae := p()
+	expect(t, "test", av)
+	expect(t, nil, ae)
+
+	ts := <-timings
+	expect(t, false, ts.Created.IsZero())
+	expect(t, false, ts.Started.Before(ts.Created))
+	expect(t, false, ts.Waited.Before(ts.Created))
+	expect(t, true, ts.Settled.After(ts.Waited))
+	expect(t, true, ts.Settled.After(ts.Started))
+}
+
+// TestWithDetach ensures that promise.WithDetach detaches a Promise from the cancellation of its Context
+// 1. the result remains available after the Context is done
+// 2. Await with the Context still stops waiting when it is done
+func TestWithDetach(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	release := make(chan struct{})
+	p := promise.Me(ctx, func() (string, error) {
+		<-release
+		return "value", nil
+	}, promise.WithDetach())
+	cancel()
+
+	av, ae := p.Await(ctx)
+	expect(t, "", av)
+	expectNotCompleted(t, ctx, ae)
+
+	close(release)
+	av, ae = p()
+	expect(t, "value", av)
+	expect(t, nil, ae)
+}
+
+// TestWithDetachProducers ensures that promise.WithDetach also detaches the Context passed to producers
+// 1. ForEach calls are not cancelled when the parent Context is cancelled, and every result is provided
+// 2. MeJoin2 producers are not cancelled when the parent Context is cancelled
+// 3. ScopeMe producers are not cancelled when the Scope is closed
+func TestWithDetachProducers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	release := make(chan struct{})
+	produce := func(ctx context.Context, i int) (int, error) {
+		started <- struct{}{}
+		<-release
+		return i, ctx.Err()
+	}
+
+	each := promise.ForEach(ctx, []int{1, 2}, 0, produce, promise.WithDetach())
+	join := promise.MeJoin2(ctx,
+		func(ctx context.Context) (int, error) { return produce(ctx, 3) },
+		func(ctx context.Context) (int, error) { return produce(ctx, 4) },
+		promise.WithDetach(),
+	)
+	for i := 0; i < 4; i++ {
+		<-started
+	}
+	cancel()
+	close(release)
+
+	av, ae := each()
+	expect(t, nil, ae)
+	expect(t, 2, len(av))
+	for i, v := range []int{1, 2} {
+		expect(t, v, av[i])
+	}
+
+	pair, ae := join()
+	expect(t, promise.Pair[int, int]{First: 3, Second: 4}, pair)
+	expect(t, nil, ae)
+
+	s := promise.NewScope(context.Background())
+	scoped := promise.ScopeMe(s, func(ctx context.Context) (string, error) {
+		started <- struct{}{}
+		return "value", context.Cause(ctx)
+	}, promise.WithDetach())
+	<-started
+	s.Close()
+
+	sv, ae := scoped()
+	expect(t, "value", sv)
+	expect(t, nil, ae)
+}
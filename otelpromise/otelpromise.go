@@ -0,0 +1,66 @@
+// Package otelpromise propagates OpenTelemetry traces across the promise boundary.
+// The producer of a Promise is run under a span that is a child of the caller's span,
+// and the time spent waiting on a Promise is recorded as an event on the waiter's span.
+package otelpromise
+
+import (
+	"context"
+	"time"
+
+	"github.com/nabowler/promise"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// ScopeName is the instrumentation scope of the Tracer used when none is provided
+	ScopeName = "github.com/nabowler/promise/otelpromise"
+
+	// AwaitEventName is the name of the span event recorded by Await
+	AwaitEventName = "promise.await"
+
+	// WaitDurationKey is the attribute of the AwaitEventName event holding the wait duration in seconds
+	WaitDurationKey = attribute.Key("promise.wait.duration")
+)
+
+// Me returns a Promise that will provide the result of complete, as promise.Me.
+// complete is passed a Context holding a span named name, started from the span in ctx,
+// which covers its execution and records its error.
+// The global TracerProvider is used; see MeWithTracer to provide a Tracer.
+func Me[T any](ctx context.Context, name string, complete func(context.Context) (T, error), opts ...trace.SpanStartOption) promise.Promise[T] {
+	return MeWithTracer(ctx, otel.Tracer(ScopeName), name, complete, opts...)
+}
+
+// MeWithTracer is Me, using tracer to start the producer span.
+func MeWithTracer[T any](ctx context.Context, tracer trace.Tracer, name string, complete func(context.Context) (T, error), opts ...trace.SpanStartOption) promise.Promise[T] {
+	return promise.Me(ctx, func() (T, error) {
+		ctx, span := tracer.Start(ctx, name, opts...)
+		defer span.End()
+
+		t, err := complete(ctx)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return t, err
+	})
+}
+
+// Await blocks until p is resolved or ctx is done, as Promise.Await, and records the time
+// spent waiting as an AwaitEventName event on the span in ctx.
+func Await[T any](ctx context.Context, p promise.Promise[T]) (T, error) {
+	start := time.Now()
+	t, err := p.Await(ctx)
+
+	attrs := []attribute.KeyValue{
+		WaitDurationKey.Float64(time.Since(start).Seconds()),
+	}
+	if err != nil {
+		attrs = append(attrs, attribute.String("error", err.Error()))
+	}
+	trace.SpanFromContext(ctx).AddEvent(AwaitEventName, trace.WithAttributes(attrs...))
+
+	return t, err
+}
@@ -0,0 +1,59 @@
+package otelpromise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/nabowler/promise/otelpromise"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func expect(t *testing.T, expected, actual any) {
+	if expected != actual {
+		t.Errorf("expected %v: got %v", expected, actual)
+	}
+}
+
+// TestMeWithTracer ensures that the producer span is a child of the caller's span
+// 1. the producer is passed a Context holding the producer span
+// 2. the producer span is recorded as a child of the caller span
+// 3. errors from the producer are recorded on the producer span
+func TestMeWithTracer(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	ctx, parent := tracer.Start(context.Background(), "parent")
+	err := fmt.Errorf("some error")
+	var producerSpan trace.SpanContext
+	p := otelpromise.MeWithTracer(ctx, tracer, "producer", func(ctx context.Context) (string, error) {
+		producerSpan = trace.SpanContextFromContext(ctx)
+		return "test", err
+	})
+
+	av, ae := otelpromise.Await(ctx, p)
+	parent.End()
+	expect(t, "test", av)
+	expect(t, err, ae)
+
+	spans := recorder.Ended()
+	expect(t, 2, len(spans))
+	producer, caller := spans[0], spans[1]
+	expect(t, "producer", producer.Name())
+	expect(t, producerSpan.SpanID(), producer.SpanContext().SpanID())
+	expect(t, caller.SpanContext().SpanID(), producer.Parent().SpanID())
+	expect(t, codes.Error, producer.Status().Code)
+	expect(t, 1, len(producer.Events()))
+
+	events := caller.Events()
+	expect(t, 1, len(events))
+	expect(t, otelpromise.AwaitEventName, events[0].Name)
+	var found bool
+	for _, attr := range events[0].Attributes {
+		found = found || attr.Key == otelpromise.WaitDurationKey
+	}
+	expect(t, true, found)
+}
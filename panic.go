@@ -0,0 +1,49 @@
+package promise
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+type (
+	// PanicError is provided by a Promise whose producer panicked
+	PanicError struct {
+		// Value is the value passed to panic
+		Value any
+		// Stack is the stack trace of the panicking goroutine
+		Stack []byte
+	}
+)
+
+// CompleteWith calls fn and completes c with its result.
+// If fn panics, c is completed with the default value for T and a *PanicError.
+// CompleteWith blocks until fn returns; call it in a goroutine to fulfill c in the background.
+func CompleteWith[T any](c Complete[T], fn func() (T, error)) {
+	c(recovered(currentObserver(), fn))
+}
+
+// recovered calls fn, converting a panic into a *PanicError and notifying o, if any
+func recovered[T any](o Observer, fn func() (T, error)) (t T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if o != nil {
+				o.PanicRecovered(r)
+			}
+			var zero T
+			t, err = zero, &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+	return fn()
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("promise: producer panicked: %v", e.Value)
+}
+
+// Unwrap returns Value if it is an error
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
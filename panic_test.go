@@ -0,0 +1,52 @@
+package promise_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+// TestCompleteWith ensures expected behavior of promise.CompleteWith in the happy path
+func TestCompleteWith(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			p, c := promise.You[string](context.Background())
+			go promise.CompleteWith(c, func() (string, error) {
+				return tc.val, tc.err
+			})
+			av, ae := p()
+			expect(t, tc.val, av)
+			expect(t, tc.err, ae)
+		})
+	}
+}
+
+// TestCompleteWithPanic ensures that promise.CompleteWith recovers from a panic
+// 1. the default value of T and a *PanicError are returned
+// 2. the PanicError holds the panic value and a stack trace
+// 3. a panic with an error can be unwrapped to that error
+func TestCompleteWithPanic(t *testing.T) {
+	p, c := promise.You[string](context.Background())
+	promise.CompleteWith(c, func() (string, error) {
+		panic("oops")
+	})
+	av, ae := p()
+	expect(t, "", av)
+	var pe *promise.PanicError
+	expect(t, true, errors.As(ae, &pe))
+	expect(t, "oops", pe.Value)
+	expect(t, true, len(pe.Stack) > 0)
+	expect(t, "promise: producer panicked: oops", ae.Error())
+
+	err := fmt.Errorf("some error")
+	p, c = promise.You[string](context.Background())
+	promise.CompleteWith(c, func() (string, error) {
+		panic(err)
+	})
+	_, ae = p()
+	expect(t, true, errors.Is(ae, err))
+}
@@ -0,0 +1,61 @@
+package promise
+
+import "context"
+
+type (
+	// Pipeline is a chain of stages, each receiving the output of the previous, that is run
+	// asynchronously on an input of type I to produce a Promise of O.
+	// Stages are only run while the previous stage succeeded and the Pipeline's Context is not done.
+	// Use Then to add a stage with the same output type, or Pipe to add a stage that changes it.
+	// Pipelines are immutable: adding a stage returns a new Pipeline.
+	Pipeline[I, O any] struct {
+		ctx context.Context
+		run func(context.Context, I) (O, error)
+	}
+)
+
+// NewPipeline returns a Pipeline without stages, which provides its input unchanged.
+// ctx is passed to every stage.
+func NewPipeline[I any](ctx context.Context) Pipeline[I, I] {
+	return Pipeline[I, I]{
+		ctx: ctx,
+		run: func(_ context.Context, i I) (I, error) {
+			return i, nil
+		},
+	}
+}
+
+// Then returns a Pipeline that runs stage on the output of p
+func (p Pipeline[I, O]) Then(stage func(context.Context, O) (O, error)) Pipeline[I, O] {
+	return Pipe(p, stage)
+}
+
+// Pipe returns a Pipeline that runs stage on the output of p, changing the output type to U
+func Pipe[I, O, U any](p Pipeline[I, O], stage func(context.Context, O) (U, error)) Pipeline[I, U] {
+	run := p.run
+	return Pipeline[I, U]{
+		ctx: p.ctx,
+		run: func(ctx context.Context, i I) (U, error) {
+			var u U
+			o, err := run(ctx, i)
+			if err != nil {
+				return u, err
+			}
+			if ctx.Err() != nil {
+				return u, notCompleted(ctx)
+			}
+			return stage(ctx, o)
+		},
+	}
+}
+
+// Run returns a Promise that will provide the output of the final stage of p when run on input.
+// If any stage returns an error, later stages are not run, and the default value for O
+// and the error will be returned.
+// If the Context is done before the final stage, the default value for O
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func (p Pipeline[I, O]) Run(input I) Promise[O] {
+	return Me(p.ctx, func() (O, error) {
+		return p.run(p.ctx, input)
+	})
+}
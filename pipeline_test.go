@@ -0,0 +1,73 @@
+package promise_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+// TestPipeline ensures expected behavior of promise.Pipeline in the happy path
+// 1. every stage is run, in order, on the output of the previous stage
+// 2. stages may change the output type
+// 3. a Pipeline may be run many times
+func TestPipeline(t *testing.T) {
+	trim := func(_ context.Context, s string) (string, error) {
+		return strings.TrimSpace(s), nil
+	}
+	atoi := func(_ context.Context, s string) (int, error) {
+		return strconv.Atoi(s)
+	}
+	double := func(_ context.Context, i int) (int, error) {
+		return i * 2, nil
+	}
+
+	p := promise.Pipe(promise.NewPipeline[string](context.Background()).Then(trim), atoi).Then(double).Then(double)
+	for i, input := range []string{" 1 ", "2", " 21"} {
+		av, ae := p.Run(input)()
+		expect(t, nil, ae)
+		expect(t, []int{4, 8, 84}[i], av)
+	}
+
+	av, ae := promise.NewPipeline[string](context.Background()).Run("test")()
+	expect(t, "test", av)
+	expect(t, nil, ae)
+}
+
+// TestPipelineError ensures that promise.Pipeline does not run stages after an error
+func TestPipelineError(t *testing.T) {
+	err := fmt.Errorf("some error")
+	p := promise.NewPipeline[int](context.Background()).
+		Then(func(context.Context, int) (int, error) {
+			return 0, err
+		}).
+		Then(func(context.Context, int) (int, error) {
+			t.Errorf("stage should not be run")
+			return 0, nil
+		})
+
+	av, ae := p.Run(1)()
+	expect(t, 0, av)
+	expect(t, err, ae)
+}
+
+// TestPipelineCancelled ensures that promise.Pipeline does not run stages after the context is done
+func TestPipelineCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := promise.NewPipeline[int](ctx).
+		Then(func(_ context.Context, i int) (int, error) {
+			cancel()
+			return i, nil
+		}).
+		Then(func(context.Context, int) (int, error) {
+			t.Errorf("stage should not be run")
+			return 0, nil
+		})
+
+	av, ae := p.Run(1)()
+	expect(t, 0, av)
+	expectNotCompleted(t, ctx, ae)
+}
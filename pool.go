@@ -0,0 +1,110 @@
+package promise
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+)
+
+type (
+	// Pool runs functions with at most a fixed number running at once.
+	// Functions waiting to run are started in order of priority, highest first,
+	// and in the order they were submitted within a priority.
+	// A Pool must be created with NewPool. It starts no goroutines while idle, so needs no closing.
+	Pool struct {
+		limit int
+
+		mu      sync.Mutex
+		running int
+		seq     uint64
+		queue   poolQueue
+	}
+
+	poolTask struct {
+		priority int
+		seq      uint64
+		run      func()
+	}
+
+	// poolQueue implements heap.Interface, with the highest priority, then earliest, task first
+	poolQueue []poolTask
+)
+
+// NewPool returns a Pool that will run at most limit functions at once.
+// A limit less than 1 is treated as 1.
+func NewPool(limit int) *Pool {
+	if limit < 1 {
+		limit = 1
+	}
+	return &Pool{limit: limit}
+}
+
+// PoolMe returns a Promise that will provide the result of complete, run within pool at the given priority.
+// PoolMe will not block, even if pool is at its concurrency limit.
+// ctx is passed to complete. If the Context is done before complete, the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned, and complete
+// will not be started if it is still waiting to run.
+func PoolMe[T any](ctx context.Context, pool *Pool, priority int, complete func(context.Context) (T, error)) Promise[T] {
+	f := NewFuture[T](ctx)
+
+	pool.submit(priority, func() {
+		if ctx.Err() != nil {
+			// f has already been abandoned
+			return
+		}
+		f.Complete(complete(ctx))
+	})
+
+	return f.Promise()
+}
+
+// submit runs fn immediately if pool has capacity, or queues it otherwise
+func (pool *Pool) submit(priority int, fn func()) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if pool.running < pool.limit {
+		pool.running++
+		spawn(func() { pool.work(fn) })
+		return
+	}
+	pool.seq++
+	heap.Push(&pool.queue, poolTask{priority: priority, seq: pool.seq, run: fn})
+}
+
+// work runs fn, then every queued task in turn until the queue is empty
+func (pool *Pool) work(fn func()) {
+	for {
+		fn()
+
+		pool.mu.Lock()
+		if pool.queue.Len() == 0 {
+			pool.running--
+			pool.mu.Unlock()
+			return
+		}
+		fn = heap.Pop(&pool.queue).(poolTask).run
+		pool.mu.Unlock()
+	}
+}
+
+func (q poolQueue) Len() int { return len(q) }
+
+func (q poolQueue) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority > q[j].priority
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q poolQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *poolQueue) Push(x any) { *q = append(*q, x.(poolTask)) }
+
+func (q *poolQueue) Pop() any {
+	old := *q
+	t := old[len(old)-1]
+	old[len(old)-1] = poolTask{}
+	*q = old[:len(old)-1]
+	return t
+}
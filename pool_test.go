@@ -0,0 +1,109 @@
+package promise_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestPool ensures expected behavior of promise.Pool
+// 1. the result of each function is returned
+// 2. no more than limit functions run at once
+func TestPool(t *testing.T) {
+	const limit = 3
+	pool := promise.NewPool(limit)
+	var running, max atomic.Int32
+
+	var ps []promise.Promise[int]
+	for i := 0; i < 20; i++ {
+		ps = append(ps, promise.PoolMe(context.Background(), pool, 0, func(context.Context) (int, error) {
+			n := running.Add(1)
+			for {
+				m := max.Load()
+				if n <= m || max.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			running.Add(-1)
+			return i, nil
+		}))
+	}
+
+	for i, p := range ps {
+		av, ae := p()
+		expect(t, i, av)
+		expect(t, nil, ae)
+	}
+	if m := max.Load(); m > limit {
+		t.Errorf("expected at most %d concurrent functions: got %d", limit, m)
+	}
+}
+
+// TestPoolPriority ensures that queued functions start in order of priority, then submission
+func TestPoolPriority(t *testing.T) {
+	pool := promise.NewPool(1)
+	release := make(chan struct{})
+	blocker := promise.PoolMe(context.Background(), pool, 0, func(context.Context) (int, error) {
+		<-release
+		return 0, nil
+	})
+
+	var (
+		mu    sync.Mutex
+		order []int
+	)
+	var ps []promise.Promise[int]
+	for i, priority := range []int{1, 5, 1, 10, 5} {
+		ps = append(ps, promise.PoolMe(context.Background(), pool, priority, func(context.Context) (int, error) {
+			mu.Lock()
+			order = append(order, i)
+			mu.Unlock()
+			return i, nil
+		}))
+	}
+	close(release)
+
+	_, _ = blocker()
+	for _, p := range ps {
+		_, _ = p()
+	}
+	expected := []int{3, 1, 4, 0, 2}
+	expect(t, len(expected), len(order))
+	for i := range expected {
+		expect(t, expected[i], order[i])
+	}
+}
+
+// TestPoolCancelled ensures that queued functions whose context is done are not started,
+// and return an error wrapping ErrNotCompleted and ctx.Err()
+func TestPoolCancelled(t *testing.T) {
+	pool := promise.NewPool(1)
+	release := make(chan struct{})
+	blocker := promise.PoolMe(context.Background(), pool, 0, func(context.Context) (int, error) {
+		<-release
+		return 0, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p := promise.PoolMe(ctx, pool, 0, func(context.Context) (int, error) {
+		t.Errorf("function should not be called")
+		return 1, nil
+	})
+	cancel()
+	av, ae := p()
+	expect(t, 0, av)
+	expectNotCompleted(t, ctx, ae)
+
+	close(release)
+	_, _ = blocker()
+	av, ae = promise.PoolMe(context.Background(), pool, 0, func(context.Context) (int, error) {
+		return 2, nil
+	})()
+	expect(t, 2, av)
+	expect(t, nil, ae)
+}
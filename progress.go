@@ -0,0 +1,61 @@
+package promise
+
+import (
+	"context"
+	"sync"
+)
+
+type (
+	progress[P any] struct {
+		mu     sync.Mutex
+		ch     chan P
+		closed bool
+	}
+)
+
+// MeWithProgress returns a Promise that will provide the result of complete, and a channel
+// of the progress complete reports while running.
+// report never blocks: if the previous progress has not been received, it is replaced by
+// the latest, so slow consumers always see the most recent progress.
+// The progress channel is closed once complete returns; later calls to report will no-op.
+// If the Context is done before complete, the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func MeWithProgress[T, P any](ctx context.Context, complete func(report func(P)) (T, error)) (Promise[T], <-chan P) {
+	p, c := You[T](ctx)
+	prog := &progress[P]{ch: make(chan P, 1)}
+
+	spawn(func() {
+		t, err := complete(prog.report)
+		prog.close()
+		c(t, err)
+	})
+
+	return p, prog.ch
+}
+
+func (p *progress[P]) report(v P) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return
+	}
+
+	select {
+	case p.ch <- v:
+	default:
+		// drop the stale progress in favor of v. p.mu guarantees there is no other sender,
+		// so there will be room after the drain.
+		select {
+		case <-p.ch:
+		default:
+		}
+		p.ch <- v
+	}
+}
+
+func (p *progress[P]) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+	close(p.ch)
+}
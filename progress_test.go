@@ -0,0 +1,56 @@
+package promise_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+// TestMeWithProgress ensures expected behavior of promise.MeWithProgress
+// 1. progress is received while complete is running
+// 2. the progress channel is closed once complete returns
+// 3. the expected value and error are returned
+func TestMeWithProgress(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			step := make(chan struct{})
+			p, progress := promise.MeWithProgress(context.Background(), func(report func(int)) (string, error) {
+				for i := 1; i <= 3; i++ {
+					report(i)
+					<-step
+				}
+				return tc.val, tc.err
+			})
+
+			for i := 1; i <= 3; i++ {
+				expect(t, i, <-progress)
+				step <- struct{}{}
+			}
+			_, ok := <-progress
+			expect(t, false, ok)
+
+			av, ae := p()
+			expect(t, tc.val, av)
+			expect(t, tc.err, ae)
+		})
+	}
+}
+
+// TestMeWithProgressLatest ensures that report does not block and keeps the latest progress
+// when the consumer is not receiving
+func TestMeWithProgressLatest(t *testing.T) {
+	p, progress := promise.MeWithProgress(context.Background(), func(report func(int)) (string, error) {
+		for i := 1; i <= 100; i++ {
+			report(i)
+		}
+		return "test", nil
+	})
+
+	av, _ := p()
+	expect(t, "test", av)
+	expect(t, 100, <-progress)
+	_, ok := <-progress
+	expect(t, false, ok)
+}
@@ -2,7 +2,7 @@ package promise
 
 import (
 	"context"
-	"sync"
+	"time"
 )
 
 type (
@@ -17,77 +17,64 @@ type (
 
 	// Complete is a non-blocking function that will fulfill a Promise created by YouNoError
 	CompleteNoError[T any] func(T)
-
-	tuple[T any] struct {
-		val T
-		err error
-	}
 )
 
 // Me returns a Promise that will provide the result of complete.
 // If the Context is done before complete, the default value for T
-// and ctx.Err() will be returned.
-func Me[T any](ctx context.Context, complete func() (T, error)) Promise[T] {
-	p, c := You[T](ctx)
-
-	go func() {
-		t, err := complete()
-		c(t, err)
-	}()
-
-	return p
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+// opts may be used to further configure the Promise.
+func Me[T any](ctx context.Context, complete func() (T, error), opts ...Option) Promise[T] {
+	o := newOptions(opts)
+	f := newFuture[T](ctx, o)
+
+	spawn(func() {
+		f.start()
+		if o.recover {
+			f.Complete(recovered(o.observer, complete))
+			return
+		}
+		f.Complete(complete())
+	})
+
+	return f.Promise()
 }
 
 // Me returns a Promise that will provide the result of complete.
 // If the Context is done before complete, the default value for T
 // is returned and ctx.Err() will be ignored.
-func MeNoError[T any](ctx context.Context, complete func() T) PromiseNoError[T] {
-	p, c := YouNoError[T](ctx)
-
-	go func() {
-		c(complete())
-	}()
-
-	return p
+func MeNoError[T any](ctx context.Context, complete func() T, opts ...Option) PromiseNoError[T] {
+	o := newOptions(opts)
+	f := newFuture[T](ctx, o)
+
+	spawn(func() {
+		f.start()
+		if o.recover {
+			t, _ := recovered(o.observer, func() (T, error) {
+				return complete(), nil
+			})
+			f.Complete(t, nil)
+			return
+		}
+		f.Complete(complete(), nil)
+	})
+
+	return f.PromiseNoError()
 }
 
 // You returns a Promise and a Completion.
 // The Promise will block until Complete is called.
 // The first call to Complete will set the return values for the Promise.
 // Subsequent calls to Complete will no-op.
-func You[T any](ctx context.Context) (Promise[T], Complete[T]) {
-	// a buffered channel of 1 is used to ensure that if ctx.Done()
-	// is the selected case in the promise readOnce, the first call to Complete
-	// will still be able to write to the channel without blocking
-	// and everything _should_ be able to be garbage collected.
-	ch := make(chan tuple[T], 1)
-
-	// Create a promise that will block until the first call to Complete.
-	// Subsequent calls will return the same results.
-	readOnce := sync.Once{}
-	var tup tuple[T]
-	p := func() (T, error) {
-		readOnce.Do(func() {
-			select {
-			case tup = <-ch:
-			case <-ctx.Done():
-				tup.err = ctx.Err()
-			}
-		})
-
-		return tup.val, tup.err
+// If the Context is done before Complete, the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+// opts may be used to further configure the Promise.
+func You[T any](ctx context.Context, opts ...Option) (Promise[T], Complete[T]) {
+	f := NewFuture[T](ctx, opts...)
+	h := watchLeak(f)
+	return f.Promise(), func(t T, err error) {
+		h.keepAlive()
+		f.Complete(t, err)
 	}
-
-	// create a Complete that will only allow a single call to set the value
-	setOnce := sync.Once{}
-	complete := func(t T, err error) {
-		setOnce.Do(func() {
-			ch <- tuple[T]{t, err}
-			close(ch)
-		})
-	}
-
-	return p, complete
 }
 
 // YouNoError returns a Promise and a Completion.
@@ -96,29 +83,94 @@ func You[T any](ctx context.Context) (Promise[T], Complete[T]) {
 // Subsequent calls to Complete will no-op.
 // If the Context is done before complete, the default value for T
 // is returned and ctx.Err() will be ignored.
-func YouNoError[T any](ctx context.Context) (PromiseNoError[T], CompleteNoError[T]) {
-	// as above, so below (just without an error value to consider)
-	ch := make(chan T, 1)
+func YouNoError[T any](ctx context.Context, opts ...Option) (PromiseNoError[T], CompleteNoError[T]) {
+	f := NewFuture[T](ctx, opts...)
+	h := watchLeak(f)
+	return f.PromiseNoError(), func(t T) {
+		h.keepAlive()
+		f.Complete(t, nil)
+	}
+}
 
-	readOnce := sync.Once{}
-	var t T
-	p := func() T {
-		readOnce.Do(func() {
-			select {
-			case t = <-ch:
-			case <-ctx.Done():
-			}
-		})
-		return t
+// Await blocks until the Promise is resolved or ctx is done, whichever happens first.
+// If ctx is done first, the default value for T and an error wrapping both
+// ErrNotCompleted and ctx.Err() will be returned.
+// ctx only governs this call: the Promise, and any work fulfilling it, is unaffected
+// and other callers will continue to receive its eventual result.
+// If p was created from a Future, as by Me, You, and most combinators, Await waits on the Future directly.
+// Otherwise, p can only be observed by calling it, so a goroutine calls p, and remains blocked on it
+// until p returns, even once ctx is done.
+func (p Promise[T]) Await(ctx context.Context) (T, error) {
+	if f := futureOf(p); f != nil {
+		return f.Get(ctx)
+	}
+
+	// buffered so the goroutine can always deliver and exit once p resolves,
+	// even if nobody is left to receive
+	ch := make(chan Result[T], 1)
+	spawn(func() {
+		t, err := p()
+		ch <- Result[T]{t, err}
+	})
+
+	select {
+	case r := <-ch:
+		return r.Unwrap()
+	case <-ctx.Done():
+		var t T
+		return t, notCompleted(ctx)
+	}
+}
+
+// AwaitTimeout blocks until p is resolved or d has passed, whichever happens first.
+// If d passes first, the default value for T and ErrAwaitTimeout will be returned.
+// d only governs this call: p, and any work fulfilling it, is unaffected
+// and other callers will continue to receive its eventual result.
+// As with Await, a goroutine is only started if p was not created from a Future,
+// and remains blocked on p until it returns.
+func AwaitTimeout[T any](p Promise[T], d time.Duration) (T, error) {
+	if f := futureOf(p); f != nil {
+		timer := clock().NewTimer(d)
+		defer timer.Stop()
+		if !f.wait(nil, timer.C()) {
+			var t T
+			return t, ErrAwaitTimeout
+		}
+		return f.val, f.err
 	}
 
-	setOnce := sync.Once{}
-	complete := func(t T) {
-		setOnce.Do(func() {
-			ch <- t
-			close(ch)
-		})
+	ch := make(chan Result[T], 1)
+	spawn(func() {
+		t, err := p()
+		ch <- Result[T]{t, err}
+	})
+
+	timer := clock().NewTimer(d)
+	defer timer.Stop()
+	select {
+	case r := <-ch:
+		return r.Unwrap()
+	case <-timer.C():
+		var t T
+		return t, ErrAwaitTimeout
 	}
+}
+
+// Await blocks until the Promise is resolved or ctx is done, whichever happens first.
+// If ctx is done first, the default value for T will be returned.
+// ctx only governs this call: the Promise, and any work fulfilling it, is unaffected
+// and other callers will continue to receive its eventual result.
+func (p PromiseNoError[T]) Await(ctx context.Context) T {
+	ch := make(chan T, 1)
+	spawn(func() {
+		ch <- p()
+	})
 
-	return p, complete
+	select {
+	case t := <-ch:
+		return t
+	case <-ctx.Done():
+		var t T
+		return t
+	}
 }
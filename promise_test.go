@@ -1,8 +1,12 @@
 package promise_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
+
+	"github.com/nabowler/promise"
 )
 
 type (
@@ -32,4 +36,14 @@ func expect(t *testing.T, expected, actual any) {
 	}
 }
 
+// expectNotCompleted ensures that err wraps both promise.ErrNotCompleted and ctx.Err()
+func expectNotCompleted(t *testing.T, ctx context.Context, err error) {
+	if !errors.Is(err, promise.ErrNotCompleted) {
+		t.Errorf("expected %v: got %v", promise.ErrNotCompleted, err)
+	}
+	if !errors.Is(err, ctx.Err()) {
+		t.Errorf("expected %v: got %v", ctx.Err(), err)
+	}
+}
+
 // actual test cases are located in promiseme_test and promiseyou_test to keep file sizes down
@@ -0,0 +1,47 @@
+// Package promisehttp provides utilities for serving the results of promises over HTTP.
+package promisehttp
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/nabowler/promise"
+)
+
+// ServePromise awaits p, honoring the Context of r, and responds with its result.
+// On success, encode writes the value to w. On failure, a plain text status is written:
+//   - 504 Gateway Timeout if the error is a timeout, including r's deadline being exceeded
+//   - 503 Service Unavailable if the error is a cancellation, including r being cancelled
+//   - 500 Internal Server Error otherwise, including a recovered panic
+//
+// The error itself is never written to w, as it may contain details clients should not see.
+// ServePromise returns the error p failed with, or the error from encode, so that callers may log it.
+func ServePromise[T any](w http.ResponseWriter, r *http.Request, p promise.Promise[T], encode func(http.ResponseWriter, T) error) error {
+	t, err := p.Await(r.Context())
+	if err != nil {
+		code := StatusCode(err)
+		http.Error(w, http.StatusText(code), code)
+		return err
+	}
+	return encode(w, t)
+}
+
+// StatusCode returns the HTTP status ServePromise responds with for err
+func StatusCode(err error) int {
+	switch {
+	case err == nil:
+		return http.StatusOK
+	case promise.IsTimeout(err):
+		return http.StatusGatewayTimeout
+	case promise.IsCanceled(err):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// JSON is an encode function for ServePromise that writes t as JSON
+func JSON[T any](w http.ResponseWriter, t T) error {
+	w.Header().Set("Content-Type", "application/json")
+	return json.NewEncoder(w).Encode(t)
+}
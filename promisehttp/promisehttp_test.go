@@ -0,0 +1,91 @@
+package promisehttp_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+	"github.com/nabowler/promise/promisehttp"
+)
+
+// TestServePromise ensures expected behavior of promisehttp.ServePromise
+// 1. the encoded value is written on success
+// 2. failures are mapped to status codes without writing the error
+// 3. the error is returned
+func TestServePromise(t *testing.T) {
+	pending, _ := promise.You[string](context.Background())
+	err := fmt.Errorf("secret details")
+	testCases := map[string]struct {
+		p    promise.Promise[string]
+		ctx  func() (context.Context, context.CancelFunc)
+		code int
+		body string
+		err  bool
+	}{
+		"success": {
+			p:    promise.Resolved("test"),
+			code: http.StatusOK,
+			body: "\"test\"\n",
+		},
+		"error": {
+			p:    promise.Rejected[string](err),
+			code: http.StatusInternalServerError,
+			err:  true,
+		},
+		"timeout": {
+			p:    promise.Rejected[string](promise.ErrTimeout),
+			code: http.StatusGatewayTimeout,
+			err:  true,
+		},
+		"deadline": {
+			p: pending,
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 10*time.Millisecond)
+			},
+			code: http.StatusGatewayTimeout,
+			err:  true,
+		},
+		"cancelled": {
+			p: pending,
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx, cancel
+			},
+			code: http.StatusServiceUnavailable,
+			err:  true,
+		},
+	}
+
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.ctx != nil {
+				ctx, cancel := tc.ctx()
+				defer cancel()
+				r = r.WithContext(ctx)
+			}
+			w := httptest.NewRecorder()
+
+			ae := promisehttp.ServePromise(w, r, tc.p, promisehttp.JSON[string])
+			if (ae != nil) != tc.err {
+				t.Errorf("expected error %v: got %v", tc.err, ae)
+			}
+			if w.Code != tc.code {
+				t.Errorf("expected %v: got %v", tc.code, w.Code)
+			}
+			if tc.body != "" && w.Body.String() != tc.body {
+				t.Errorf("expected %q: got %q", tc.body, w.Body.String())
+			}
+			if strings.Contains(w.Body.String(), err.Error()) {
+				t.Errorf("expected the error not to be written: got %q", w.Body.String())
+			}
+		})
+	}
+}
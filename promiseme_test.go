@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/nabowler/promise"
+	"github.com/nabowler/promise/promisetest"
 )
 
 // TestMe ensures expected behavior of promise.Me in the happy path
@@ -33,8 +34,8 @@ func TestMe(t *testing.T) {
 
 // TestMeCancelled ensures expected behavior of promise.Me on the non-happy path
 // when the context is done
-// 1. the default value of T and ctx.Err() are returned when ctx is done
-// 2. the default value of T and ctx.Err() continue to be returned on all calls
+// 1. the default value of T and an error wrapping ErrNotCompleted and ctx.Err() are returned when ctx is done
+// 2. the default value of T and the same error continue to be returned on all calls
 func TestMeCancelled(t *testing.T) {
 	for name, testcase := range testCases {
 		tc := testcase
@@ -45,17 +46,16 @@ func TestMeCancelled(t *testing.T) {
 		cancel()
 
 		t.Run(name, func(t *testing.T) {
+			// hold the producer so that the result cannot race ctx.Done()
+			promisetest.NewScheduler(t)
 			p := promise.Me(ctx, func() (string, error) {
-				// 3 seconds should be long enough for the runtime to
-				// choose to select <- ctx.Done()
-				time.Sleep(3 * time.Second)
 				return tc.val, tc.err
 			})
 
 			for i := 0; i < 10; i++ {
 				av, ae := p()
 				expect(t, expectedVal, av)
-				expect(t, ctx.Err(), ae)
+				expectNotCompleted(t, ctx, ae)
 			}
 		})
 	}
@@ -93,10 +93,9 @@ func TestMeNoErrorCancelled(t *testing.T) {
 		cancel()
 
 		t.Run(name, func(t *testing.T) {
+			// hold the producer so that the result cannot race ctx.Done()
+			promisetest.NewScheduler(t)
 			p := promise.MeNoError(ctx, func() string {
-				// 3 seconds should be long enough for the runtime to
-				// choose to select <- ctx.Done()
-				time.Sleep(3 * time.Second)
 				return tc
 			})
 			for i := 0; i < 10; i++ {
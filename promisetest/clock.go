@@ -0,0 +1,153 @@
+package promisetest
+
+import (
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+type (
+	// Clock is a fake promise.Clock whose time only moves when Advance is called,
+	// so that timeouts, delays, and retries can be tested deterministically without real sleeps.
+	// Timers fire synchronously within Advance, in the order they are due.
+	Clock struct {
+		mu     sync.Mutex
+		cond   *sync.Cond
+		now    time.Time
+		timers []*clockTimer
+	}
+
+	clockTimer struct {
+		c    *Clock
+		when time.Time
+		fn   func()
+		ch   chan time.Time
+		// active reports if the timer is in c.timers
+		active bool
+	}
+)
+
+// NewClock returns a Clock set as the package's promise.Clock until the test ends.
+// Tests using a Clock must not run in parallel with other tests using the package.
+func NewClock(t testing.TB) *Clock {
+	c := &Clock{now: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)}
+	c.cond = sync.NewCond(&c.mu)
+
+	promise.SetClock(c)
+	t.Cleanup(func() {
+		promise.SetClock(nil)
+	})
+	return c
+}
+
+// Now returns the current fake time
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// AfterFunc returns a Timer that calls f once the Clock has been advanced by d
+func (c *Clock) AfterFunc(d time.Duration, f func()) promise.Timer {
+	return c.add(d, f, nil)
+}
+
+// NewTimer returns a Timer that sends the time on its channel once the Clock has been advanced by d
+func (c *Clock) NewTimer(d time.Duration) promise.Timer {
+	return c.add(d, nil, make(chan time.Time, 1))
+}
+
+// Advance moves the Clock forward by d, firing every Timer that becomes due, in order.
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	target := c.now.Add(d)
+	for len(c.timers) > 0 && !c.timers[0].when.After(target) {
+		t := c.timers[0]
+		c.remove(t)
+		c.now = t.when
+		c.mu.Unlock()
+		t.fire()
+		c.mu.Lock()
+	}
+	c.now = target
+	c.mu.Unlock()
+}
+
+// WaitForTimers blocks until at least n Timers are active, so that a test can be sure a goroutine
+// in the package has started waiting on the Clock before advancing it.
+func (c *Clock) WaitForTimers(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.timers) < n {
+		c.cond.Wait()
+	}
+}
+
+func (c *Clock) add(d time.Duration, fn func(), ch chan time.Time) *clockTimer {
+	t := &clockTimer{c: c, fn: fn, ch: ch}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.schedule(t, d)
+	return t
+}
+
+// schedule makes t due after d. c.mu must be held.
+func (c *Clock) schedule(t *clockTimer, d time.Duration) {
+	t.when = c.now.Add(d)
+	if !t.active {
+		t.active = true
+		c.timers = append(c.timers, t)
+	}
+	sort.SliceStable(c.timers, func(i, j int) bool {
+		return c.timers[i].when.Before(c.timers[j].when)
+	})
+	c.cond.Broadcast()
+}
+
+// remove deactivates t. c.mu must be held.
+func (c *Clock) remove(t *clockTimer) {
+	if !t.active {
+		return
+	}
+	t.active = false
+	for i, other := range c.timers {
+		if other == t {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			return
+		}
+	}
+}
+
+func (t *clockTimer) fire() {
+	if t.fn != nil {
+		t.fn()
+		return
+	}
+	select {
+	case t.ch <- t.when:
+	default:
+	}
+}
+
+func (t *clockTimer) C() <-chan time.Time {
+	return t.ch
+}
+
+func (t *clockTimer) Stop() bool {
+	t.c.mu.Lock()
+	defer t.c.mu.Unlock()
+	active := t.active
+	t.c.remove(t)
+	return active
+}
+
+func (t *clockTimer) Reset(d time.Duration) bool {
+	t.c.mu.Lock()
+	defer t.c.mu.Unlock()
+	active := t.active
+	t.c.schedule(t, d)
+	return active
+}
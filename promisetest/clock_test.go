@@ -0,0 +1,78 @@
+package promisetest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+	"github.com/nabowler/promise/promisetest"
+)
+
+// TestClock ensures expected behavior of promisetest.Clock
+// 1. time only moves when the Clock is advanced
+// 2. Timers fire once they are due, in order
+// 3. stopped Timers do not fire, and reset Timers fire at their new time
+func TestClock(t *testing.T) {
+	c := promisetest.NewClock(t)
+	start := c.Now()
+
+	var fired []string
+	c.AfterFunc(2*time.Second, func() { fired = append(fired, "b") })
+	c.AfterFunc(time.Second, func() { fired = append(fired, "a") })
+	stopped := c.AfterFunc(time.Second, func() { fired = append(fired, "stopped") })
+	reset := c.AfterFunc(time.Second, func() { fired = append(fired, "reset") })
+	ch := c.NewTimer(3 * time.Second)
+	expect(t, true, stopped.Stop())
+	expect(t, true, reset.Reset(5*time.Second))
+
+	c.Advance(3 * time.Second)
+	expect(t, start.Add(3*time.Second), c.Now())
+	expect(t, "[a b]", fmt.Sprint(fired))
+	select {
+	case now := <-ch.C():
+		expect(t, start.Add(3*time.Second), now)
+	default:
+		t.Errorf("expected the Timer to have fired")
+	}
+
+	c.Advance(2 * time.Second)
+	expect(t, "[a b reset]", fmt.Sprint(fired))
+	expect(t, false, reset.Stop())
+}
+
+// TestClockPromises ensures that promisetest.Clock drives the timers of the promise package
+// 1. After resolves once the Clock is advanced
+// 2. WithTimeout settles with ErrTimeout once the Clock is advanced
+// 3. MeRetry waits on the Clock between attempts
+func TestClockPromises(t *testing.T) {
+	c := promisetest.NewClock(t)
+
+	p := promise.After(context.Background(), time.Hour, "test")
+	promisetest.AssertPendingFor(t, p, time.Millisecond)
+	c.Advance(time.Hour)
+	av, ae := promisetest.AssertSettledWithin(t, p, time.Second)
+	expect(t, "test", av)
+	expect(t, nil, ae)
+
+	timedOut, _ := promise.You[string](context.Background(), promise.WithTimeout(time.Minute))
+	c.Advance(time.Minute)
+	_, ae = promisetest.AssertSettledWithin(t, timedOut, time.Second)
+	expect(t, promise.ErrTimeout, ae)
+
+	err := errors.New("some error")
+	attempts := 0
+	retried := promise.MeRetry(context.Background(), func() (int, error) {
+		attempts++
+		return attempts, err
+	}, promise.RetryOptions{Attempts: 3, Backoff: time.Hour})
+	for i := 0; i < 2; i++ {
+		c.WaitForTimers(1)
+		c.Advance(time.Duration(1<<i) * time.Hour)
+	}
+	attempt, ae := promisetest.AssertSettledWithin(t, retried, time.Second)
+	expect(t, 3, attempt)
+	expect(t, err, ae)
+}
@@ -0,0 +1,109 @@
+// Package promisetest provides utilities for testing code that produces or consumes promises.
+package promisetest
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+type (
+	// Completer is a controllable producer for a Promise, allowing a test to decide
+	// when and how the Promise is resolved.
+	// Any callers still waiting on the Promise when the test ends will be released
+	// with an error wrapping promise.ErrNotCompleted.
+	Completer[T any] struct {
+		p         promise.Promise[T]
+		c         promise.Complete[T]
+		completed atomic.Bool
+	}
+)
+
+// NewCompleter returns a Completer whose Promise is pending until Resolve or Reject is called.
+func NewCompleter[T any](t testing.TB) *Completer[T] {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	p, c := promise.You[T](ctx)
+	return &Completer[T]{p: p, c: c}
+}
+
+// Promise returns the Promise controlled by c
+func (c *Completer[T]) Promise() promise.Promise[T] {
+	return c.p
+}
+
+// Resolve completes the Promise with t and a nil error
+func (c *Completer[T]) Resolve(t T) {
+	c.Complete(t, nil)
+}
+
+// Reject completes the Promise with the default value for T and err
+func (c *Completer[T]) Reject(err error) {
+	var t T
+	c.Complete(t, err)
+}
+
+// Complete completes the Promise with t and err
+func (c *Completer[T]) Complete(t T, err error) {
+	c.completed.Store(true)
+	c.c(t, err)
+}
+
+// Completed reports if Resolve, Reject, or Complete has been called
+func (c *Completer[T]) Completed() bool {
+	return c.completed.Load()
+}
+
+// ResolveAfter returns a Promise that will provide t and a nil error once d has passed.
+// Any callers still waiting when the test ends will be released with an error
+// wrapping promise.ErrNotCompleted.
+func ResolveAfter[T any](t testing.TB, d time.Duration, v T) promise.Promise[T] {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	return promise.After(ctx, d, v)
+}
+
+// Pending returns a Promise that will never be resolved.
+// Calling it will block forever; use Promise.Await to wait with a deadline.
+func Pending[T any]() promise.Promise[T] {
+	p, _ := promise.You[T](context.Background())
+	return p
+}
+
+// AssertSettledWithin fails the test immediately if p is not resolved within d,
+// and otherwise returns the result of p.
+func AssertSettledWithin[T any](t testing.TB, p promise.Promise[T], d time.Duration) (T, error) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+
+	v, err := p.Await(ctx)
+	if timedOut(ctx, err) {
+		t.Fatalf("expected promise to settle within %v", d)
+	}
+	return v, err
+}
+
+// AssertPendingFor fails the test if p is resolved within d.
+func AssertPendingFor[T any](t testing.TB, p promise.Promise[T], d time.Duration) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+
+	if v, err := p.Await(ctx); !timedOut(ctx, err) {
+		t.Errorf("expected promise to be pending for %v: got %v, %v", d, v, err)
+	}
+}
+
+// timedOut reports if err is the result of ctx being done, rather than the awaited Promise's own result
+func timedOut(ctx context.Context, err error) bool {
+	return ctx.Err() != nil && errors.Is(err, promise.ErrNotCompleted) && errors.Is(err, ctx.Err())
+}
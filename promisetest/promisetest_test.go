@@ -0,0 +1,104 @@
+package promisetest_test
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise/promisetest"
+)
+
+type (
+	// recordingTB records failures instead of failing the test
+	recordingTB struct {
+		testing.TB
+		mu     sync.Mutex
+		failed bool
+	}
+)
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(string, ...any) {
+	r.mu.Lock()
+	r.failed = true
+	r.mu.Unlock()
+}
+
+func (r *recordingTB) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+	runtime.Goexit()
+}
+
+func (r *recordingTB) Failed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.failed
+}
+
+// run calls fn with a recordingTB in its own goroutine, so that Fatalf may exit it
+func run(t *testing.T, fn func(tb testing.TB)) bool {
+	tb := &recordingTB{TB: t}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn(tb)
+	}()
+	<-done
+	return tb.Failed()
+}
+
+func expect(t *testing.T, expected, actual any) {
+	if expected != actual {
+		t.Errorf("expected %v: got %v", expected, actual)
+	}
+}
+
+// TestCompleter ensures expected behavior of promisetest.Completer
+// 1. the Promise is pending until Resolve is called
+// 2. the Promise provides the resolved value
+// 3. Reject provides the error
+func TestCompleter(t *testing.T) {
+	c := promisetest.NewCompleter[string](t)
+	expect(t, false, c.Completed())
+	promisetest.AssertPendingFor(t, c.Promise(), 10*time.Millisecond)
+
+	c.Resolve("test")
+	expect(t, true, c.Completed())
+	av, ae := promisetest.AssertSettledWithin(t, c.Promise(), time.Second)
+	expect(t, "test", av)
+	expect(t, nil, ae)
+
+	err := fmt.Errorf("some error")
+	c = promisetest.NewCompleter[string](t)
+	c.Reject(err)
+	av, ae = promisetest.AssertSettledWithin(t, c.Promise(), time.Second)
+	expect(t, "", av)
+	expect(t, err, ae)
+}
+
+// TestResolveAfter ensures that promisetest.ResolveAfter resolves only after d
+func TestResolveAfter(t *testing.T) {
+	p := promisetest.ResolveAfter(t, 20*time.Millisecond, "test")
+	promisetest.AssertPendingFor(t, p, time.Millisecond)
+	av, ae := promisetest.AssertSettledWithin(t, p, time.Second)
+	expect(t, "test", av)
+	expect(t, nil, ae)
+}
+
+// TestAssertions ensures that the assertions fail when their expectation is not met
+// 1. AssertSettledWithin fails for a Pending Promise
+// 2. AssertPendingFor fails for a resolved Promise
+func TestAssertions(t *testing.T) {
+	expect(t, true, run(t, func(tb testing.TB) {
+		promisetest.AssertSettledWithin(tb, promisetest.Pending[string](), 10*time.Millisecond)
+	}))
+	expect(t, true, run(t, func(tb testing.TB) {
+		promisetest.AssertPendingFor(tb, promisetest.ResolveAfter(tb, 0, "test"), time.Second)
+	}))
+	expect(t, false, run(t, func(tb testing.TB) {
+		promisetest.AssertPendingFor(tb, promisetest.Pending[string](), 10*time.Millisecond)
+	}))
+}
@@ -0,0 +1,86 @@
+package promisetest
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+type (
+	// Scheduler queues every goroutine the promise package would start, so that a test can
+	// step through them one at a time, in the order they were started.
+	// Functions that block until work in the package finishes, such as promise.Wait, must be called
+	// from a goroutine other than the one stepping the Scheduler, or they will never return.
+	Scheduler struct {
+		mu    sync.Mutex
+		cond  *sync.Cond
+		queue []func()
+	}
+)
+
+// NewScheduler returns a Scheduler set as the package's spawner until the test ends.
+// Any functions still queued when the test ends are started in their own goroutines.
+// Tests using a Scheduler must not run in parallel with other tests using the package.
+func NewScheduler(t testing.TB) *Scheduler {
+	s := &Scheduler{}
+	s.cond = sync.NewCond(&s.mu)
+
+	promise.SetSpawner(s.spawn)
+	t.Cleanup(func() {
+		promise.SetSpawner(nil)
+		s.mu.Lock()
+		queue := s.queue
+		s.queue = nil
+		s.mu.Unlock()
+		for _, fn := range queue {
+			go fn()
+		}
+	})
+	return s
+}
+
+// Pending returns the number of queued functions
+func (s *Scheduler) Pending() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.queue)
+}
+
+// WaitForPending blocks until at least n functions are queued
+func (s *Scheduler) WaitForPending(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for len(s.queue) < n {
+		s.cond.Wait()
+	}
+}
+
+// Step runs the oldest queued function on the calling goroutine, returning once it does.
+// Step reports false if no function was queued.
+func (s *Scheduler) Step() bool {
+	s.mu.Lock()
+	if len(s.queue) == 0 {
+		s.mu.Unlock()
+		return false
+	}
+	fn := s.queue[0]
+	s.queue = s.queue[1:]
+	s.mu.Unlock()
+
+	fn()
+	return true
+}
+
+// RunUntilIdle steps until no functions are queued, including those queued by earlier steps
+func (s *Scheduler) RunUntilIdle() {
+	for s.Step() {
+	}
+}
+
+func (s *Scheduler) spawn(fn func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queue = append(s.queue, fn)
+	s.cond.Broadcast()
+}
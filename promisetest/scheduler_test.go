@@ -0,0 +1,47 @@
+package promisetest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nabowler/promise"
+	"github.com/nabowler/promise/promisetest"
+)
+
+// TestScheduler ensures expected behavior of promisetest.Scheduler
+// 1. goroutines started by the promise package are queued rather than run
+// 2. Step runs queued functions in order
+// 3. RunUntilIdle runs functions queued by earlier steps
+func TestScheduler(t *testing.T) {
+	s := promisetest.NewScheduler(t)
+
+	var order []int
+	first := promise.Me(context.Background(), func() (int, error) {
+		order = append(order, 1)
+		return 1, nil
+	})
+	promise.Me(context.Background(), func() (int, error) {
+		order = append(order, 2)
+		return 2, nil
+	})
+	expect(t, 2, s.Pending())
+	expect(t, 0, len(order))
+
+	expect(t, true, s.Step())
+	expect(t, 1, len(order))
+	expect(t, 1, order[0])
+	av, ae := first()
+	expect(t, 1, av)
+	expect(t, nil, ae)
+
+	called := false
+	promise.OnComplete(first, func(int, error) {
+		called = true
+	})
+	s.RunUntilIdle()
+	expect(t, 2, len(order))
+	expect(t, 2, order[1])
+	expect(t, true, called)
+	expect(t, 0, s.Pending())
+	expect(t, false, s.Step())
+}
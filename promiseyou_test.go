@@ -36,8 +36,8 @@ func TestYou(t *testing.T) {
 
 // TestYouCancelled ensures expected behavior of promise.You on the non-happy path
 // when the context is done
-// 1. the default value of T and ctx.Err() are returned when ctx is done
-// 2. the default value of T and ctx.Err() continue to be returned on all calls
+// 1. the default value of T and an error wrapping ErrNotCompleted and ctx.Err() are returned when ctx is done
+// 2. the default value of T and the same error continue to be returned on all calls
 // 3. subsequent calls to Complete do not block, and do not change the
 // returned values of the Promise
 func TestYouCancelled(t *testing.T) {
@@ -55,7 +55,7 @@ func TestYouCancelled(t *testing.T) {
 			for i := 0; i < 10; i++ {
 				av, ae := p()
 				expect(t, expectedVal, av)
-				expect(t, ctx.Err(), ae)
+				expectNotCompleted(t, ctx, ae)
 				c(tc.val, tc.err)
 			}
 		})
@@ -154,3 +154,42 @@ func TestYouHTTPRequests(t *testing.T) {
 	// wait for consumers to finish
 	wg.Wait()
 }
+
+// TestYouAllocs ensures that creating, completing, and calling a Promise from You
+// on a Context that can never be done does not allocate more than the Future, its Promise, and its Complete
+func TestYouAllocs(t *testing.T) {
+	ctx := context.Background()
+	allocs := testing.AllocsPerRun(100, func() {
+		p, c := promise.You[int](ctx)
+		c(1, nil)
+		_, _ = p()
+	})
+	if allocs > 3 {
+		t.Errorf("expected at most 3 allocations: got %v", allocs)
+	}
+}
+
+// BenchmarkYou measures creating, completing, and calling a Promise from You
+func BenchmarkYou(b *testing.B) {
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		p, c := promise.You[int](ctx)
+		c(i, nil)
+		_, _ = p()
+	}
+}
+
+// BenchmarkYouCancellable measures BenchmarkYou with a Context that can be done, which must be watched
+func BenchmarkYouCancellable(b *testing.B) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		p, c := promise.You[int](ctx)
+		c(i, nil)
+		_, _ = p()
+	}
+}
@@ -0,0 +1,61 @@
+package promise
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type (
+	renewable[T any] struct {
+		first *Future[T]
+
+		mu    sync.Mutex
+		val   T
+		valid bool
+	}
+)
+
+// Renewable returns a function that provides a Promise for the most recent successful result of complete.
+// complete is started immediately, and again interval after each call returns, until ctx is done.
+// Until the first successful result arrives, the provided Promise blocks; afterwards it resolves
+// immediately with the latest value, so callers never wait on a refresh.
+// Errors are discarded, and the previous value continues to be provided until a later call succeeds.
+// ctx is passed to complete. If the Context is done before the first successful result, the default
+// value for T and an error wrapping both ErrNotCompleted and ctx.Err() will be returned; otherwise
+// the last value is provided indefinitely.
+func Renewable[T any](ctx context.Context, interval time.Duration, complete func(context.Context) (T, error)) func() Promise[T] {
+	r := &renewable[T]{first: NewFuture[T](ctx)}
+
+	spawn(func() {
+		timer := clock().NewTimer(0)
+		defer timer.Stop()
+		for {
+			select {
+			case <-timer.C():
+			case <-ctx.Done():
+				return
+			}
+
+			if t, err := complete(ctx); err == nil && ctx.Err() == nil {
+				r.mu.Lock()
+				r.val, r.valid = t, true
+				r.mu.Unlock()
+				r.first.Complete(t, nil)
+			}
+			timer.Reset(interval)
+		}
+	})
+
+	return r.get
+}
+
+func (r *renewable[T]) get() Promise[T] {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.valid {
+		return Resolved(r.val)
+	}
+	return r.first.Promise()
+}
@@ -0,0 +1,75 @@
+package promise_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+	"github.com/nabowler/promise/promisetest"
+)
+
+// TestRenewable ensures expected behavior of promise.Renewable in the happy path
+// 1. the first Promise blocks until the initial value arrives
+// 2. later Promises provide the refreshed value
+// 3. errors are discarded and the previous value continues to be provided
+func TestRenewable(t *testing.T) {
+	c := promisetest.NewClock(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	release := make(chan struct{})
+	var calls atomic.Int32
+	get := promise.Renewable(ctx, 10*time.Millisecond, func(context.Context) (int, error) {
+		n := calls.Add(1)
+		switch n {
+		case 1:
+			<-release
+		case 3:
+			return 0, fmt.Errorf("some error")
+		}
+		return int(n), nil
+	})
+
+	// the first call starts once the producer is waiting on the Clock
+	c.WaitForTimers(1)
+	c.Advance(0)
+	first := get()
+	go close(release)
+	av, ae := first()
+	expect(t, 1, av)
+	expect(t, nil, ae)
+
+	// each call has returned once the producer is waiting on the Clock again
+	for _, expected := range []int{2, 2, 4} {
+		c.WaitForTimers(1)
+		c.Advance(10 * time.Millisecond)
+		c.WaitForTimers(1)
+		av, ae = get()()
+		expect(t, expected, av)
+		expect(t, nil, ae)
+	}
+	expect(t, int32(4), calls.Load())
+}
+
+// TestRenewableCancelled ensures that promise.Renewable returns an error wrapping ErrNotCompleted
+// and ctx.Err() when the Context is done before the first successful result
+func TestRenewableCancelled(t *testing.T) {
+	c := promisetest.NewClock(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	get := promise.Renewable(ctx, time.Millisecond, func(context.Context) (string, error) {
+		return "", fmt.Errorf("some error")
+	})
+	c.WaitForTimers(1)
+	c.Advance(0)
+	c.WaitForTimers(1)
+	cancel()
+
+	av, ae := get()()
+	expect(t, "", av)
+	expectNotCompleted(t, ctx, ae)
+}
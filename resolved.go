@@ -0,0 +1,26 @@
+package promise
+
+// Resolved returns a Promise that has already been fulfilled with t and a nil error.
+// No goroutines or channels are created.
+func Resolved[T any](t T) Promise[T] {
+	return func() (T, error) {
+		return t, nil
+	}
+}
+
+// Rejected returns a Promise that has already been fulfilled with the default value for T and err.
+// No goroutines or channels are created.
+func Rejected[T any](err error) Promise[T] {
+	return func() (T, error) {
+		var t T
+		return t, err
+	}
+}
+
+// ResolvedNoError returns a PromiseNoError that has already been fulfilled with t.
+// No goroutines or channels are created.
+func ResolvedNoError[T any](t T) PromiseNoError[T] {
+	return func() T {
+		return t
+	}
+}
@@ -0,0 +1,53 @@
+package promise_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+// TestResolved ensures expected behavior of promise.Resolved
+// 1. the expected value and a nil error are returned
+// 2. the expected value and a nil error continue to be returned on all calls
+func TestResolved(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			p := promise.Resolved(tc.val)
+			for i := 0; i < 10; i++ {
+				av, ae := p()
+				expect(t, tc.val, av)
+				expect(t, nil, ae)
+			}
+		})
+	}
+}
+
+// TestRejected ensures expected behavior of promise.Rejected
+// 1. the default value of T and the expected error are returned
+// 2. the default value of T and the expected error continue to be returned on all calls
+func TestRejected(t *testing.T) {
+	err := fmt.Errorf("some error")
+	p := promise.Rejected[string](err)
+	for i := 0; i < 10; i++ {
+		av, ae := p()
+		expect(t, "", av)
+		expect(t, err, ae)
+	}
+}
+
+// TestResolvedNoError ensures expected behavior of promise.ResolvedNoError
+// 1. the expected value is returned
+// 2. the expected value continues to be returned on all calls
+func TestResolvedNoError(t *testing.T) {
+	for name, testcase := range noErrorTestCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			p := promise.ResolvedNoError(tc)
+			for i := 0; i < 10; i++ {
+				expect(t, tc, p())
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package promise
+
+import (
+	"context"
+	"sync"
+)
+
+type (
+	// Result holds the value and error a Promise resolved with
+	Result[T any] struct {
+		Value T
+		Err   error
+	}
+
+	// IndexedResult holds the value and error for the input at Index, for results provided out of order
+	IndexedResult[T any] struct {
+		Index int
+		Value T
+		Err   error
+	}
+)
+
+// Ok returns a Result holding t and a nil error
+func Ok[T any](t T) Result[T] {
+	return Result[T]{Value: t}
+}
+
+// Err returns a Result holding the default value for T and err
+func Err[T any](err error) Result[T] {
+	return Result[T]{Err: err}
+}
+
+// Unwrap returns the value and error held by the Result
+func (r Result[T]) Unwrap() (T, error) {
+	return r.Value, r.Err
+}
+
+// AllSettled blocks until every Promise is resolved, or ctx is done, and returns their Results
+// in the same order as ps.
+// Promises not resolved before ctx is done provide the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err().
+func AllSettled[T any](ctx context.Context, ps ...Promise[T]) []Result[T] {
+	results := make([]Result[T], len(ps))
+
+	wg := sync.WaitGroup{}
+	for i, p := range ps {
+		wg.Add(1)
+		spawn(func() {
+			defer wg.Done()
+			results[i].Value, results[i].Err = p.Await(ctx)
+		})
+	}
+	wg.Wait()
+
+	return results
+}
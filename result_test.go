@@ -0,0 +1,51 @@
+package promise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestResult ensures expected behavior of the promise.Result helpers
+// 1. Ok holds the value and a nil error
+// 2. Err holds the default value for T and the error
+// 3. Unwrap returns the held value and error
+func TestResult(t *testing.T) {
+	av, ae := promise.Ok("test").Unwrap()
+	expect(t, "test", av)
+	expect(t, nil, ae)
+
+	err := fmt.Errorf("some error")
+	av, ae = promise.Err[string](err).Unwrap()
+	expect(t, "", av)
+	expect(t, err, ae)
+}
+
+// TestAllSettled ensures expected behavior of promise.AllSettled
+// 1. a Result is returned for every Promise, in order, whether it succeeded or failed
+// 2. Promises not resolved before ctx is done provide an error wrapping ErrNotCompleted and ctx.Err()
+func TestAllSettled(t *testing.T) {
+	err := fmt.Errorf("some error")
+	results := promise.AllSettled(context.Background(),
+		promise.Resolved("a"),
+		promise.Rejected[string](err),
+		promise.Resolved("c"),
+	)
+	expected := []promise.Result[string]{promise.Ok("a"), promise.Err[string](err), promise.Ok("c")}
+	expect(t, len(expected), len(results))
+	for i := range expected {
+		expect(t, expected[i], results[i])
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	pending, _ := promise.You[string](context.Background())
+	results = promise.AllSettled(ctx, promise.Resolved("a"), pending)
+	expect(t, 2, len(results))
+	expect(t, promise.Ok("a"), results[0])
+	expect(t, "", results[1].Value)
+	expectNotCompleted(t, ctx, results[1].Err)
+}
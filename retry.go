@@ -0,0 +1,81 @@
+package promise
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+type (
+	// RetryOptions configures how MeRetry retries a failing function
+	RetryOptions struct {
+		// Attempts is the maximum number of times the function will be called.
+		// Values less than 1 are treated as 1.
+		Attempts int
+
+		// Backoff is the delay before the first retry.
+		// The delay doubles for each subsequent retry.
+		Backoff time.Duration
+
+		// Jitter is the fraction, from 0 to 1, of each delay that is randomized.
+		// A Jitter of 0.5 will wait somewhere between half of, and the full, delay.
+		Jitter float64
+
+		// RetryIf reports if an error should be retried.
+		// If nil, all errors are retried.
+		RetryIf func(error) bool
+	}
+)
+
+// MeRetry returns a Promise that will provide the result of complete,
+// calling complete again while it returns an error, up to opts.Attempts times,
+// waiting with exponential backoff between attempts.
+// The result of the last attempt will be provided.
+// If the Context is done before complete, the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned,
+// and no further attempts will be made.
+func MeRetry[T any](ctx context.Context, complete func() (T, error), opts RetryOptions) Promise[T] {
+	p, c := You[T](ctx)
+
+	spawn(func() {
+		t, err, ok := retry(ctx, complete, opts)
+		if ok {
+			c(t, err)
+		}
+	})
+
+	return p
+}
+
+// retry calls complete until it succeeds, should not be retried, or ctx is done.
+// ok will be false if ctx was done while waiting to retry.
+func retry[T any](ctx context.Context, complete func() (T, error), opts RetryOptions) (t T, err error, ok bool) {
+	delay := opts.Backoff
+	for attempt := 1; ; attempt++ {
+		t, err = complete()
+		if err == nil || attempt >= opts.Attempts || (opts.RetryIf != nil && !opts.RetryIf(err)) {
+			return t, err, true
+		}
+
+		timer := clock().NewTimer(opts.jitter(delay))
+		select {
+		case <-timer.C():
+		case <-ctx.Done():
+			// the Promise will provide ErrNotCompleted; completing here would only race it
+			timer.Stop()
+			return t, err, false
+		}
+		delay *= 2
+	}
+}
+
+func (opts RetryOptions) jitter(d time.Duration) time.Duration {
+	j := opts.Jitter
+	if j <= 0 || d <= 0 {
+		return d
+	}
+	if j > 1 {
+		j = 1
+	}
+	return d - time.Duration(rand.Float64()*j*float64(d))
+}
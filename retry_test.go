@@ -0,0 +1,88 @@
+package promise_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestMeRetry ensures expected behavior of promise.MeRetry in the happy path
+// 1. complete is retried until it succeeds
+// 2. the successful value is returned on all calls
+func TestMeRetry(t *testing.T) {
+	var calls int32
+	p := promise.MeRetry(context.Background(), func() (string, error) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			return "", fmt.Errorf("some error")
+		}
+		return "test", nil
+	}, promise.RetryOptions{Attempts: 5, Backoff: time.Millisecond, Jitter: 0.5})
+
+	for i := 0; i < 10; i++ {
+		av, ae := p()
+		expect(t, "test", av)
+		expect(t, nil, ae)
+	}
+	expect(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+// TestMeRetryExhausted ensures that promise.MeRetry stops after opts.Attempts
+// 1. complete is called opts.Attempts times
+// 2. the value and error of the last attempt are returned
+func TestMeRetryExhausted(t *testing.T) {
+	var calls int32
+	p := promise.MeRetry(context.Background(), func() (int32, error) {
+		n := atomic.AddInt32(&calls, 1)
+		return n, fmt.Errorf("attempt %d", n)
+	}, promise.RetryOptions{Attempts: 3, Backoff: time.Millisecond})
+
+	av, ae := p()
+	expect(t, int32(3), av)
+	expect(t, "attempt 3", ae.Error())
+	expect(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+// TestMeRetryIf ensures that promise.MeRetry does not retry errors rejected by RetryIf
+func TestMeRetryIf(t *testing.T) {
+	permanent := errors.New("permanent")
+	var calls int32
+	p := promise.MeRetry(context.Background(), func() (string, error) {
+		atomic.AddInt32(&calls, 1)
+		return "", permanent
+	}, promise.RetryOptions{
+		Attempts: 5,
+		Backoff:  time.Millisecond,
+		RetryIf: func(err error) bool {
+			return !errors.Is(err, permanent)
+		},
+	})
+
+	_, ae := p()
+	expect(t, permanent, ae)
+	expect(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+// TestMeRetryCancelled ensures that promise.MeRetry stops retrying when ctx is done
+// 1. the default value of T and an error wrapping ErrNotCompleted and ctx.Err() are returned
+// 2. no further attempts are made
+func TestMeRetryCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int32
+	p := promise.MeRetry(ctx, func() (string, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			cancel()
+		}
+		return "", fmt.Errorf("some error")
+	}, promise.RetryOptions{Attempts: 5, Backoff: time.Hour})
+
+	av, ae := p()
+	expect(t, "", av)
+	expectNotCompleted(t, ctx, ae)
+	time.Sleep(10 * time.Millisecond)
+	expect(t, int32(1), atomic.LoadInt32(&calls))
+}
@@ -0,0 +1,75 @@
+package promise
+
+import (
+	"context"
+	"sync"
+)
+
+type (
+	// Scope tracks the Promises and producers started within it, such as for a single request,
+	// so that all of them can be cleaned up together with Close.
+	// A Scope must be created with NewScope.
+	Scope struct {
+		ctx    context.Context
+		cancel context.CancelCauseFunc
+		wg     sync.WaitGroup
+
+		mu     sync.Mutex
+		closed bool
+	}
+)
+
+// NewScope returns a Scope whose Promises use a Context derived from ctx.
+func NewScope(ctx context.Context) *Scope {
+	ctx, cancel := context.WithCancelCause(ctx)
+	return &Scope{
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// ScopeMe returns a Promise that will provide the result of complete, run within s.
+// complete is passed the Context of s, which is cancelled by Close unless WithDetach is given,
+// and is not started if s is closed.
+// If the Context is done before complete, including by Close, the default value for T and an error
+// wrapping ErrNotCompleted, ctx.Err(), and, if closed, ErrScopeClosed will be returned.
+func ScopeMe[T any](s *Scope, complete func(context.Context) (T, error), opts ...Option) Promise[T] {
+	o := newOptions(opts)
+	f := newFuture[T](s.ctx, o)
+	ctx := o.producerContext(s.ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return f.Promise()
+	}
+	s.wg.Add(1)
+	spawn(func() {
+		defer s.wg.Done()
+		f.Complete(complete(ctx))
+	})
+
+	return f.Promise()
+}
+
+// ScopeYou is You using the Context of s, so that the Promise is settled by Close if still pending.
+func ScopeYou[T any](s *Scope, opts ...Option) (Promise[T], Complete[T]) {
+	return You[T](s.ctx, opts...)
+}
+
+// Context returns the Context of s, which is cancelled with the cause ErrScopeClosed by Close
+func (s *Scope) Context() context.Context {
+	return s.ctx
+}
+
+// Close cancels the Context of s, settling every pending Promise in s with an error wrapping
+// ErrNotCompleted and ErrScopeClosed, and blocks until every producer started by ScopeMe has returned.
+// Subsequent calls only wait for the producers.
+func (s *Scope) Close() {
+	s.mu.Lock()
+	s.closed = true
+	s.mu.Unlock()
+
+	s.cancel(ErrScopeClosed)
+	s.wg.Wait()
+}
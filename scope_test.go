@@ -0,0 +1,56 @@
+package promise_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+// TestScope ensures expected behavior of promise.Scope
+// 1. the results of Promises completed before Close are unaffected
+// 2. Close cancels the Context passed to running producers and waits for them to return
+// 3. pending Promises are settled with an error wrapping ErrNotCompleted and ErrScopeClosed
+// 4. producers are not started once the Scope is closed
+func TestScope(t *testing.T) {
+	s := promise.NewScope(context.Background())
+
+	done := promise.ScopeMe(s, func(context.Context) (string, error) {
+		return "test", nil
+	})
+	av, ae := done()
+	expect(t, "test", av)
+	expect(t, nil, ae)
+
+	var returned atomic.Bool
+	started := make(chan struct{})
+	running := promise.ScopeMe(s, func(ctx context.Context) (string, error) {
+		close(started)
+		<-ctx.Done()
+		returned.Store(true)
+		return "", ctx.Err()
+	})
+	pending, _ := promise.ScopeYou[string](s)
+	<-started
+	s.Close()
+
+	expect(t, true, returned.Load())
+	for _, p := range []promise.Promise[string]{running, pending} {
+		av, ae = p()
+		expect(t, "", av)
+		expectNotCompleted(t, s.Context(), ae)
+		expect(t, true, errors.Is(ae, promise.ErrScopeClosed))
+	}
+	av, ae = done()
+	expect(t, "test", av)
+	expect(t, nil, ae)
+
+	_, ae = promise.ScopeMe(s, func(context.Context) (string, error) {
+		t.Errorf("producer should not be started")
+		return "", nil
+	})()
+	expect(t, true, errors.Is(ae, promise.ErrScopeClosed))
+	s.Close()
+}
@@ -0,0 +1,43 @@
+package promise
+
+import "context"
+
+type (
+	// AnyPromise is a Promise whose value type has been erased, so that Promises of
+	// different types can be handled together, as by Select.
+	AnyPromise func() (any, error)
+)
+
+// Any returns an AnyPromise that will provide the result of p.
+func (p Promise[T]) Any() AnyPromise {
+	return func() (any, error) {
+		return p()
+	}
+}
+
+// Select blocks until the first of ps is resolved, returning its index, value, and error.
+// If ctx is done first, -1, nil, and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+// As with a select statement, Select with no Promises blocks until ctx is done.
+func Select(ctx context.Context, ps ...AnyPromise) (index int, value any, err error) {
+	type selected struct {
+		index int
+		value any
+		err   error
+	}
+
+	// buffered so that the losers can always deliver their result and exit
+	ch := make(chan selected, len(ps))
+	for i, p := range ps {
+		spawn(func() {
+			v, err := p()
+			ch <- selected{i, v, err}
+		})
+	}
+
+	select {
+	case s := <-ch:
+		return s.index, s.value, s.err
+	case <-ctx.Done():
+		return -1, nil, notCompleted(ctx)
+	}
+}
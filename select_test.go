@@ -0,0 +1,45 @@
+package promise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestSelect ensures expected behavior of promise.Select
+// 1. the index, value, and error of the first resolved Promise are returned
+// 2. Promises of different types may be selected together
+func TestSelect(t *testing.T) {
+	slow, _ := promise.You[string](context.Background())
+	fast := promise.After(context.Background(), 10*time.Millisecond, 42)
+
+	i, v, err := promise.Select(context.Background(), slow.Any(), fast.Any())
+	expect(t, 1, i)
+	expect(t, 42, v)
+	expect(t, nil, err)
+
+	someErr := fmt.Errorf("some error")
+	i, v, err = promise.Select(context.Background(), promise.Rejected[bool](someErr).Any(), slow.Any())
+	expect(t, 0, i)
+	expect(t, false, v)
+	expect(t, someErr, err)
+}
+
+// TestSelectCancelled ensures expected behavior of promise.Select when the context is done
+func TestSelectCancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	pending, _ := promise.You[string](context.Background())
+
+	i, v, err := promise.Select(ctx, pending.Any())
+	expect(t, -1, i)
+	expect(t, nil, v)
+	expectNotCompleted(t, ctx, err)
+
+	i, _, err = promise.Select(ctx)
+	expect(t, -1, i)
+	expectNotCompleted(t, ctx, err)
+}
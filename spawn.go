@@ -0,0 +1,32 @@
+package promise
+
+import "sync/atomic"
+
+type (
+	spawnerHolder struct {
+		spawn func(fn func())
+	}
+)
+
+var globalSpawner atomic.Pointer[spawnerHolder]
+
+// SetSpawner sets the function used to start every goroutine the package starts, from the call onwards,
+// such as those running producers and OnComplete callbacks. spawner must eventually call each fn exactly once.
+// A nil spawner restores starting each fn with a go statement. SetSpawner is intended for tests, to
+// step through the package's goroutines deterministically; see promisetest.Scheduler.
+func SetSpawner(spawner func(fn func())) {
+	if spawner == nil {
+		globalSpawner.Store(nil)
+		return
+	}
+	globalSpawner.Store(&spawnerHolder{spawner})
+}
+
+// spawn runs fn in its own goroutine, or hands it to the spawner set with SetSpawner
+func spawn(fn func()) {
+	if h := globalSpawner.Load(); h != nil {
+		h.spawn(fn)
+		return
+	}
+	go fn()
+}
@@ -0,0 +1,122 @@
+package promise
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+type (
+	// Stream is a sequence of values provided by a producer over time, such as pages of results.
+	// Like a Promise, every iteration of a Stream will yield the same values; an iteration
+	// blocks while waiting for the producer to emit the next value.
+	// Each value is yielded with a nil error. If the producer fails, or the Stream's Context is
+	// done before the producer returns, a final default value for T and the error are yielded.
+	Stream[T any] iter.Seq2[T, error]
+
+	stream[T any] struct {
+		ctx context.Context
+
+		mu       sync.Mutex
+		vals     []T
+		finished bool
+		err      error
+		// wake is closed, and replaced, whenever vals or finished changes
+		wake chan struct{}
+	}
+)
+
+// MeStream returns a Stream of the values produce emits.
+// emit returns false once the Stream is finished, at which point produce should return.
+// If the Context is done before produce returns, the values emitted so far are followed by
+// the default value for T and an error wrapping both ErrNotCompleted and ctx.Err().
+func MeStream[T any](ctx context.Context, produce func(ctx context.Context, emit func(T) bool) error) Stream[T] {
+	s := &stream[T]{
+		ctx:  ctx,
+		wake: make(chan struct{}),
+	}
+
+	stop := context.AfterFunc(ctx, func() {
+		s.finish(notCompleted(ctx))
+	})
+	spawn(func() {
+		err := produce(ctx, s.emit)
+		stop()
+		if ctx.Err() != nil {
+			// prefer reporting that the Context was done over a result that raced it
+			err = notCompleted(ctx)
+		}
+		s.finish(err)
+	})
+
+	return s.all
+}
+
+func (s *stream[T]) emit(t T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.finished || s.ctx.Err() != nil {
+		return false
+	}
+	s.vals = append(s.vals, t)
+	s.notify()
+	return true
+}
+
+// finish ends the Stream with err. Only the first call has any effect.
+func (s *stream[T]) finish(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.finished {
+		return
+	}
+	s.finished, s.err = true, err
+	s.notify()
+}
+
+// notify wakes all waiting iterations. s.mu must be held.
+func (s *stream[T]) notify() {
+	close(s.wake)
+	s.wake = make(chan struct{})
+}
+
+func (s *stream[T]) all(yield func(T, error) bool) {
+	for i := 0; ; i++ {
+		t, err, more := s.at(i)
+		if !more {
+			if err != nil {
+				yield(t, err)
+			}
+			return
+		}
+		if !yield(t, nil) {
+			return
+		}
+	}
+}
+
+// at blocks until the i-th value is available, returning it, or the Stream is finished without it,
+// returning its error and more=false.
+func (s *stream[T]) at(i int) (t T, err error, more bool) {
+	for {
+		s.mu.Lock()
+		if i < len(s.vals) {
+			t = s.vals[i]
+			s.mu.Unlock()
+			return t, nil, true
+		}
+		if s.finished {
+			err = s.err
+			s.mu.Unlock()
+			return t, err, false
+		}
+		wake := s.wake
+		s.mu.Unlock()
+
+		select {
+		case <-wake:
+		case <-s.ctx.Done():
+			s.finish(notCompleted(s.ctx))
+		}
+	}
+}
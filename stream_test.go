@@ -0,0 +1,100 @@
+package promise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+// TestMeStream ensures expected behavior of promise.MeStream in the happy path
+// 1. every emitted value is yielded in order with a nil error
+// 2. every iteration yields the same values
+func TestMeStream(t *testing.T) {
+	s := promise.MeStream(context.Background(), func(ctx context.Context, emit func(int) bool) error {
+		for i := 0; i < 5; i++ {
+			emit(i)
+		}
+		return nil
+	})
+
+	for i := 0; i < 3; i++ {
+		var vals []int
+		for v, err := range s {
+			expect(t, nil, err)
+			vals = append(vals, v)
+		}
+		expect(t, fmt.Sprint([]int{0, 1, 2, 3, 4}), fmt.Sprint(vals))
+	}
+}
+
+// TestMeStreamError ensures that an error from the producer is yielded after the emitted values
+func TestMeStreamError(t *testing.T) {
+	err := fmt.Errorf("some error")
+	s := promise.MeStream(context.Background(), func(ctx context.Context, emit func(string) bool) error {
+		emit("test")
+		return err
+	})
+
+	var vals []string
+	var errs []error
+	for v, e := range s {
+		vals = append(vals, v)
+		errs = append(errs, e)
+	}
+	expect(t, fmt.Sprint([]string{"test", ""}), fmt.Sprint(vals))
+	expect(t, 2, len(errs))
+	expect(t, nil, errs[0])
+	expect(t, err, errs[1])
+}
+
+// TestMeStreamBreak ensures that a consumer may stop iterating early without affecting others
+func TestMeStreamBreak(t *testing.T) {
+	s := promise.MeStream(context.Background(), func(ctx context.Context, emit func(int) bool) error {
+		for i := 0; i < 5; i++ {
+			emit(i)
+		}
+		return nil
+	})
+
+	for v := range s {
+		expect(t, 0, v)
+		break
+	}
+	var count int
+	for range s {
+		count++
+	}
+	expect(t, 5, count)
+}
+
+// TestMeStreamCancelled ensures expected behavior of promise.MeStream when the context is done
+// 1. values emitted before the ctx is done are yielded
+// 2. an error wrapping ErrNotCompleted and ctx.Err() is yielded last
+// 3. emit returns false once the ctx is done
+func TestMeStreamCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	emitted := make(chan struct{})
+	stopped := make(chan bool)
+	s := promise.MeStream(ctx, func(ctx context.Context, emit func(int) bool) error {
+		emit(1)
+		close(emitted)
+		<-ctx.Done()
+		stopped <- emit(2)
+		return nil
+	})
+
+	<-emitted
+	cancel()
+	expect(t, false, <-stopped)
+
+	var vals []int
+	var last error
+	for v, err := range s {
+		vals = append(vals, v)
+		last = err
+	}
+	expect(t, fmt.Sprint([]int{1, 0}), fmt.Sprint(vals))
+	expectNotCompleted(t, ctx, last)
+}
@@ -0,0 +1,111 @@
+package promise
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// pkgPath is the import path of this package, which Promise types are declared in
+var pkgPath = reflect.TypeOf(Promise[any](nil)).PkgPath()
+
+// AwaitStruct awaits every Promise or PromiseNoError field of the struct src, concurrently,
+// and writes each value to the field of the same name in the struct pointed to by dst.
+// src may be a struct or a pointer to one. Fields are matched by their type, so only fields declared as
+// a Promise or PromiseNoError, of any type parameter, are awaited; other fields, including funcs
+// of the same shape such as func() string, and nil Promise fields, are skipped.
+// Every Promise field must have a matching field in dst that its value is assignable to.
+// AwaitStruct blocks until every Promise is resolved, or ctx is done, and returns the errors
+// of any that failed joined with errors.Join. The value of a failed Promise is still written.
+// Promises not resolved before ctx is done write the default value for their type
+// and contribute an error wrapping both ErrNotCompleted and ctx.Err().
+func AwaitStruct(ctx context.Context, src, dst any) error {
+	sv := reflect.ValueOf(src)
+	if sv.Kind() == reflect.Pointer {
+		sv = sv.Elem()
+	}
+	dv := reflect.ValueOf(dst)
+	if sv.Kind() != reflect.Struct {
+		return fmt.Errorf("promise: AwaitStruct src must be a struct, got %T", src)
+	}
+	if dv.Kind() != reflect.Pointer || dv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("promise: AwaitStruct dst must be a pointer to a struct, got %T", dst)
+	}
+	dv = dv.Elem()
+
+	type field struct {
+		p   reflect.Value
+		dst reflect.Value
+	}
+	var fields []field
+	for i := 0; i < sv.NumField(); i++ {
+		sf := sv.Type().Field(i)
+		ft := sf.Type
+		if !sf.IsExported() || !isPromiseType(ft) {
+			continue
+		}
+		if sv.Field(i).IsNil() {
+			continue
+		}
+		df := dv.FieldByName(sf.Name)
+		if !df.IsValid() || !df.CanSet() {
+			return fmt.Errorf("promise: AwaitStruct dst %s has no field %s", dv.Type(), sf.Name)
+		}
+		if !ft.Out(0).AssignableTo(df.Type()) {
+			return fmt.Errorf("promise: AwaitStruct dst field %s is %s, not assignable from %s", sf.Name, df.Type(), ft.Out(0))
+		}
+		fields = append(fields, field{sv.Field(i), df})
+	}
+
+	vals := make([]reflect.Value, len(fields))
+	errs := make([]error, len(fields))
+	wg := sync.WaitGroup{}
+	for i, f := range fields {
+		wg.Add(1)
+		spawn(func() {
+			defer wg.Done()
+			vals[i], errs[i] = awaitValue(ctx, f.p)
+		})
+	}
+	wg.Wait()
+
+	for i, f := range fields {
+		if vals[i].IsValid() {
+			f.dst.Set(vals[i])
+		} else {
+			f.dst.SetZero()
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// isPromiseType reports whether t is an instance of Promise or PromiseNoError
+func isPromiseType(t reflect.Type) bool {
+	if t.Kind() != reflect.Func || t.PkgPath() != pkgPath {
+		return false
+	}
+	name := t.Name()
+	return strings.HasPrefix(name, "Promise[") || strings.HasPrefix(name, "PromiseNoError[")
+}
+
+// awaitValue calls the Promise or PromiseNoError p, returning early if ctx is done.
+// The returned Value is invalid if ctx was done first.
+func awaitValue(ctx context.Context, p reflect.Value) (reflect.Value, error) {
+	ch := make(chan []reflect.Value, 1)
+	spawn(func() {
+		ch <- p.Call(nil)
+	})
+
+	select {
+	case out := <-ch:
+		if len(out) == 1 || out[1].IsNil() {
+			return out[0], nil
+		}
+		return out[0], out[1].Interface().(error)
+	case <-ctx.Done():
+		return reflect.Value{}, notCompleted(ctx)
+	}
+}
@@ -0,0 +1,86 @@
+package promise_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestAwaitStruct ensures expected behavior of promise.AwaitStruct in the happy path
+// 1. the value of every Promise and PromiseNoError field is written to the matching dst field
+// 2. fields that are not Promises, including funcs of the same shape, and nil Promises, are skipped
+func TestAwaitStruct(t *testing.T) {
+	src := struct {
+		Name    promise.Promise[string]
+		Age     promise.PromiseNoError[int]
+		Skipped promise.Promise[bool]
+		Other   string
+		Label   func() string
+	}{
+		Name:  promise.Resolved("test"),
+		Age:   promise.ResolvedNoError(42),
+		Other: "ignored",
+		Label: func() string {
+			t.Error("Label should not be called")
+			return "ignored"
+		},
+	}
+	var dst struct {
+		Name    string
+		Age     int
+		Skipped bool
+		Other   string
+		Label   string
+	}
+
+	expect(t, nil, promise.AwaitStruct(context.Background(), &src, &dst))
+	expect(t, "test", dst.Name)
+	expect(t, 42, dst.Age)
+	expect(t, false, dst.Skipped)
+	expect(t, "", dst.Other)
+	expect(t, "", dst.Label)
+}
+
+// TestAwaitStructErrors ensures that promise.AwaitStruct reports failures
+// 1. the errors of failed Promises are joined, and the values of the others written
+// 2. Promises not resolved before ctx is done contribute an error wrapping ErrNotCompleted and ctx.Err()
+// 3. a dst without a matching field, or with a field of the wrong type, is an error
+func TestAwaitStructErrors(t *testing.T) {
+	err := fmt.Errorf("some error")
+	pending, _ := promise.You[int](context.Background())
+	src := struct {
+		A promise.Promise[string]
+		B promise.Promise[string]
+		C promise.Promise[int]
+	}{
+		A: promise.Resolved("a"),
+		B: promise.Rejected[string](err),
+		C: pending,
+	}
+	dst := struct {
+		A, B string
+		C    int
+	}{C: 1}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	ae := promise.AwaitStruct(ctx, src, &dst)
+	expect(t, true, errors.Is(ae, err))
+	expectNotCompleted(t, ctx, ae)
+	expect(t, "a", dst.A)
+	expect(t, "", dst.B)
+	expect(t, 0, dst.C)
+
+	var missing struct{ A, B string }
+	expect(t, true, promise.AwaitStruct(context.Background(), src, &missing) != nil)
+	var mistyped struct {
+		A, B string
+		C    bool
+	}
+	expect(t, true, promise.AwaitStruct(context.Background(), src, &mistyped) != nil)
+	expect(t, true, promise.AwaitStruct(context.Background(), src, dst) != nil)
+}
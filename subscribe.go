@@ -0,0 +1,26 @@
+package promise
+
+// Subscribe returns a channel that will receive the result of p once p is resolved.
+// Each call to Subscribe returns a new channel, buffered so that delivery never blocks,
+// which receives exactly one Result and is then closed.
+func Subscribe[T any](p Promise[T]) <-chan Result[T] {
+	ch := make(chan Result[T], 1)
+	OnComplete(p, subscriber(ch))
+	return ch
+}
+
+// Subscribe returns a channel that will receive the result of the Future once it is settled.
+// Each call to Subscribe returns a new channel, buffered so that delivery never blocks,
+// which receives exactly one Result and is then closed.
+func (f *Future[T]) Subscribe() <-chan Result[T] {
+	ch := make(chan Result[T], 1)
+	f.OnComplete(subscriber(ch))
+	return ch
+}
+
+func subscriber[T any](ch chan<- Result[T]) func(T, error) {
+	return func(t T, err error) {
+		ch <- Result[T]{t, err}
+		close(ch)
+	}
+}
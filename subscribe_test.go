@@ -0,0 +1,64 @@
+package promise_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestSubscribe ensures expected behavior of promise.Subscribe
+// 1. every subscriber receives the result of the Promise
+// 2. every channel is closed after delivering the result
+func TestSubscribe(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			p, c := promise.You[string](context.Background())
+
+			var subs []<-chan promise.Result[string]
+			for i := 0; i < 10; i++ {
+				subs = append(subs, promise.Subscribe(p))
+			}
+
+			select {
+			case <-subs[0]:
+				t.Errorf("expected no result before completion")
+			case <-time.After(10 * time.Millisecond):
+			}
+
+			c(tc.val, tc.err)
+			for _, sub := range subs {
+				r := <-sub
+				expect(t, tc.val, r.Value)
+				expect(t, tc.err, r.Err)
+				_, ok := <-sub
+				expect(t, false, ok)
+			}
+		})
+	}
+}
+
+// TestFutureSubscribe ensures expected behavior of Future.Subscribe
+// 1. subscribers before and after settling receive the result of the Future
+// 2. every channel is closed after delivering the result
+func TestFutureSubscribe(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			f := promise.NewFuture[string](context.Background())
+			before := f.Subscribe()
+			f.Complete(tc.val, tc.err)
+			after := f.Subscribe()
+
+			for _, sub := range []<-chan promise.Result[string]{before, after} {
+				r := <-sub
+				expect(t, tc.val, r.Value)
+				expect(t, tc.err, r.Err)
+				_, ok := <-sub
+				expect(t, false, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package promise
+
+import "context"
+
+// Then returns a Promise that will provide the result of fn applied to the value of p.
+// fn is only called if p resolves with a nil error; otherwise the default value for U
+// and the error from p will be returned.
+func Then[T, U any](p Promise[T], fn func(T) (U, error)) Promise[U] {
+	return Me(context.Background(), func() (U, error) {
+		t, err := p()
+		if err != nil {
+			var u U
+			return u, err
+		}
+		return fn(t)
+	})
+}
+
+// FlatMap returns a Promise that will provide the result of the Promise returned by fn
+// when applied to the value of p, so that dependent asynchronous stages can be chained
+// without nesting.
+// fn is only called if p resolves with a nil error; otherwise the default value for U
+// and the error from p will be returned.
+func FlatMap[T, U any](p Promise[T], fn func(T) Promise[U]) Promise[U] {
+	return Me(context.Background(), func() (U, error) {
+		t, err := p()
+		if err != nil {
+			var u U
+			return u, err
+		}
+		return fn(t)()
+	})
+}
+
+// Catch returns a Promise that will provide the result of fn applied to the error of p,
+// allowing recovery from failures with a fallback computation.
+// fn is only called if p resolves with a non-nil error; otherwise the result of p will be returned.
+func Catch[T any](p Promise[T], fn func(error) (T, error)) Promise[T] {
+	return Me(context.Background(), func() (T, error) {
+		t, err := p()
+		if err != nil {
+			return fn(err)
+		}
+		return t, nil
+	})
+}
+
+// MapErr returns a Promise that will provide the value of p and the result of fn applied to its error,
+// allowing errors to be wrapped or annotated once for every consumer.
+// fn is only called if p resolves with a non-nil error.
+func MapErr[T any](p Promise[T], fn func(error) error) Promise[T] {
+	return Me(context.Background(), func() (T, error) {
+		t, err := p()
+		if err != nil {
+			err = fn(err)
+		}
+		return t, err
+	})
+}
+
+// Finalize returns a Promise that will provide the result of fn applied to the value and error of p,
+// such as decoding a response body or validating a token.
+// fn is called exactly once, as soon as p resolves, however many callers wait on the returned Promise,
+// and whether p resolves with a value or an error.
+func Finalize[T any](p Promise[T], fn func(T, error) (T, error)) Promise[T] {
+	return Me(context.Background(), func() (T, error) {
+		return fn(p())
+	})
+}
@@ -0,0 +1,150 @@
+package promise_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+// TestThen ensures expected behavior of promise.Then
+// 1. fn is applied to the value of a resolved Promise
+// 2. fn is not called when the Promise returns an error, and the error is passed through
+func TestThen(t *testing.T) {
+	p := promise.Then(promise.Resolved("42"), strconv.Atoi)
+	for i := 0; i < 10; i++ {
+		av, ae := p()
+		expect(t, 42, av)
+		expect(t, nil, ae)
+	}
+
+	err := fmt.Errorf("some error")
+	p = promise.Then(promise.Rejected[string](err), func(s string) (int, error) {
+		t.Errorf("fn should not be called")
+		return 0, nil
+	})
+	av, ae := p()
+	expect(t, 0, av)
+	expect(t, err, ae)
+}
+
+// TestFlatMap ensures expected behavior of promise.FlatMap
+// 1. the result of the Promise returned by fn is provided
+// 2. fn is not called when the Promise returns an error, and the error is passed through
+// 3. an error from the Promise returned by fn is provided
+func TestFlatMap(t *testing.T) {
+	p := promise.FlatMap(promise.Resolved("42"), func(s string) promise.Promise[int] {
+		return promise.Me(context.Background(), func() (int, error) {
+			return strconv.Atoi(s)
+		})
+	})
+	for i := 0; i < 10; i++ {
+		av, ae := p()
+		expect(t, 42, av)
+		expect(t, nil, ae)
+	}
+
+	err := fmt.Errorf("some error")
+	p = promise.FlatMap(promise.Rejected[string](err), func(s string) promise.Promise[int] {
+		t.Errorf("fn should not be called")
+		return promise.Resolved(0)
+	})
+	av, ae := p()
+	expect(t, 0, av)
+	expect(t, err, ae)
+
+	p = promise.FlatMap(promise.Resolved("42"), func(s string) promise.Promise[int] {
+		return promise.Rejected[int](err)
+	})
+	_, ae = p()
+	expect(t, err, ae)
+}
+
+// TestCatch ensures expected behavior of promise.Catch
+// 1. fn is applied to the error of a rejected Promise
+// 2. fn is not called when the Promise resolves with a nil error
+func TestCatch(t *testing.T) {
+	err := fmt.Errorf("some error")
+	p := promise.Catch(promise.Rejected[string](err), func(e error) (string, error) {
+		expect(t, err, e)
+		return "recovered", nil
+	})
+	for i := 0; i < 10; i++ {
+		av, ae := p()
+		expect(t, "recovered", av)
+		expect(t, nil, ae)
+	}
+
+	p = promise.Catch(promise.Resolved("test"), func(e error) (string, error) {
+		t.Errorf("fn should not be called")
+		return "", e
+	})
+	av, ae := p()
+	expect(t, "test", av)
+	expect(t, nil, ae)
+}
+
+// TestMapErr ensures expected behavior of promise.MapErr
+// 1. fn is applied to the error of a rejected Promise, and the value is passed through
+// 2. fn is not called when the Promise resolves with a nil error
+func TestMapErr(t *testing.T) {
+	err := fmt.Errorf("some error")
+	p := promise.MapErr(promise.Me(context.Background(), func() (string, error) {
+		return "partial", err
+	}), func(e error) error {
+		return fmt.Errorf("wrapped: %w", e)
+	})
+	for i := 0; i < 10; i++ {
+		av, ae := p()
+		expect(t, "partial", av)
+		expect(t, "wrapped: some error", ae.Error())
+		expect(t, true, errors.Is(ae, err))
+	}
+
+	p = promise.MapErr(promise.Resolved("test"), func(e error) error {
+		t.Errorf("fn should not be called")
+		return e
+	})
+	av, ae := p()
+	expect(t, "test", av)
+	expect(t, nil, ae)
+}
+
+// TestFinalize ensures expected behavior of promise.Finalize
+// 1. fn is called with both the value and the error of p
+// 2. fn is called exactly once, however many times the Promise is called
+// 3. the result of fn is returned on all calls
+func TestFinalize(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			var calls atomic.Int32
+			p := promise.Finalize(promise.Me(context.Background(), func() (string, error) {
+				return tc.val, tc.err
+			}), func(v string, err error) (string, error) {
+				calls.Add(1)
+				expect(t, tc.val, v)
+				expect(t, tc.err, err)
+				return v + " finalized", err
+			})
+
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					av, ae := p()
+					expect(t, tc.val+" finalized", av)
+					expect(t, tc.err, ae)
+				}()
+			}
+			wg.Wait()
+			expect(t, int32(1), calls.Load())
+		})
+	}
+}
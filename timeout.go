@@ -0,0 +1,40 @@
+package promise
+
+import (
+	"context"
+	"time"
+)
+
+// MeWithTimeout returns a Promise that will provide the result of complete.
+// If complete has not returned within d, fallback and ErrTimeout will be returned.
+// If the Context is done before complete, the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func MeWithTimeout[T any](ctx context.Context, d time.Duration, fallback T, complete func() (T, error)) Promise[T] {
+	p, c := YouWithTimeout(ctx, d, fallback)
+
+	spawn(func() {
+		t, err := complete()
+		c(t, err)
+	})
+
+	return p
+}
+
+// YouWithTimeout returns a Promise and a Completion.
+// The Promise will block until Complete is called, or d has passed.
+// If Complete has not been called within d, fallback and ErrTimeout will be returned.
+// The first call to Complete will set the return values for the Promise.
+// Subsequent calls to Complete will no-op.
+// If the Context is done before Complete, the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func YouWithTimeout[T any](ctx context.Context, d time.Duration, fallback T) (Promise[T], Complete[T]) {
+	f := NewFuture[T](ctx)
+	timer := clock().AfterFunc(d, func() {
+		f.Complete(fallback, ErrTimeout)
+	})
+	f.OnComplete(func(T, error) {
+		timer.Stop()
+	})
+
+	return f.Promise(), f.Complete
+}
@@ -0,0 +1,85 @@
+package promise_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+	"github.com/nabowler/promise/promisetest"
+)
+
+// TestMeWithTimeout ensures expected behavior of promise.MeWithTimeout in the happy path
+// 1. the expected value and error are returned when complete returns in time
+// 2. the expected value and error continue to be returned on all calls
+func TestMeWithTimeout(t *testing.T) {
+	// the timeout never passes unless the Clock is advanced
+	promisetest.NewClock(t)
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			p := promise.MeWithTimeout(context.Background(), time.Second, "fallback", func() (string, error) {
+				return tc.val, tc.err
+			})
+			for i := 0; i < 10; i++ {
+				av, ae := p()
+				expect(t, tc.val, av)
+				expect(t, tc.err, ae)
+			}
+		})
+	}
+}
+
+// TestMeWithTimeoutExpired ensures expected behavior of promise.MeWithTimeout when complete is too slow
+// 1. the fallback value and ErrTimeout are returned
+// 2. the fallback value and ErrTimeout continue to be returned on all calls
+func TestMeWithTimeoutExpired(t *testing.T) {
+	c := promisetest.NewClock(t)
+	release := make(chan struct{})
+	defer close(release)
+	p := promise.MeWithTimeout(context.Background(), 10*time.Millisecond, "fallback", func() (string, error) {
+		<-release
+		return "test", nil
+	})
+	c.Advance(10 * time.Millisecond)
+	for i := 0; i < 10; i++ {
+		av, ae := p()
+		expect(t, "fallback", av)
+		expect(t, promise.ErrTimeout, ae)
+	}
+}
+
+// TestYouWithTimeout ensures expected behavior of promise.YouWithTimeout
+// 1. the completed value is returned when Complete is called in time
+// 2. the fallback value and ErrTimeout are returned when Complete is not called in time
+// 3. calls to Complete after the timeout do not change the returned values
+func TestYouWithTimeout(t *testing.T) {
+	clk := promisetest.NewClock(t)
+	p, c := promise.YouWithTimeout(context.Background(), time.Second, "fallback")
+	c("test", nil)
+	clk.Advance(time.Second)
+	av, ae := p()
+	expect(t, "test", av)
+	expect(t, nil, ae)
+
+	p, c = promise.YouWithTimeout(context.Background(), 10*time.Millisecond, "fallback")
+	promisetest.AssertPendingFor(t, p, time.Millisecond)
+	clk.Advance(10 * time.Millisecond)
+	av, ae = p()
+	expect(t, "fallback", av)
+	expect(t, promise.ErrTimeout, ae)
+	c("test", nil)
+	av, ae = p()
+	expect(t, "fallback", av)
+	expect(t, promise.ErrTimeout, ae)
+}
+
+// TestYouWithTimeoutCancelled ensures that the Context being done takes precedence over the fallback
+func TestYouWithTimeoutCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	p, _ := promise.YouWithTimeout(ctx, time.Second, "fallback")
+	av, ae := p()
+	expect(t, "", av)
+	expectNotCompleted(t, ctx, ae)
+}
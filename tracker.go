@@ -0,0 +1,101 @@
+package promise
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+type (
+	// Tracker records the producers of the Promises started with TrackerMe, so that a service
+	// can wait for them with Drain before shutting down, rather than dropping in-flight results.
+	// The zero value is ready to use, so a Tracker may be embedded in another struct.
+	// A Tracker must not be copied after first use.
+	Tracker struct {
+		mu sync.Mutex
+		// running holds the label of each running producer by its id
+		running map[uint64]string
+		nextID  uint64
+		// idle is closed, and replaced, whenever the last running producer returns
+		idle chan struct{}
+	}
+)
+
+// TrackerMe returns a Promise that will provide the result of complete, tracked by tr until complete returns.
+// The label given with WithLabel, if any, identifies the producer if it is still running when tr is drained.
+// If the Context is done before complete, the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func TrackerMe[T any](ctx context.Context, tr *Tracker, complete func() (T, error), opts ...Option) Promise[T] {
+	done := tr.add(newOptions(opts).label)
+	return Me(ctx, func() (T, error) {
+		defer done()
+		return complete()
+	}, opts...)
+}
+
+// Drain blocks until no producers tracked by t are running, or ctx is done, whichever happens first.
+// Producers may still be started while draining; Drain returns once none are running at the same time.
+// If ctx is done first, the labels of the producers still running, in the order they were started,
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+// Producers started without a label are reported with an empty label.
+func (t *Tracker) Drain(ctx context.Context) (pending []string, err error) {
+	t.mu.Lock()
+	if len(t.running) == 0 {
+		t.mu.Unlock()
+		return nil, nil
+	}
+	idle := t.idleChan()
+	t.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil, nil
+	case <-ctx.Done():
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.running) == 0 {
+		return nil, nil
+	}
+	ids := make([]uint64, 0, len(t.running))
+	for id := range t.running {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	pending = make([]string, len(ids))
+	for i, id := range ids {
+		pending[i] = t.running[id]
+	}
+	return pending, notCompleted(ctx)
+}
+
+// add records a running producer, returning the func to call once it returns
+func (t *Tracker) add(label string) (done func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.running == nil {
+		t.running = make(map[uint64]string)
+	}
+	id := t.nextID
+	t.nextID++
+	t.running[id] = label
+
+	return func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		delete(t.running, id)
+		if len(t.running) == 0 && t.idle != nil {
+			close(t.idle)
+			t.idle = nil
+		}
+	}
+}
+
+// idleChan returns the channel closed once no producers are running. t.mu must be held.
+func (t *Tracker) idleChan() chan struct{} {
+	if t.idle == nil {
+		t.idle = make(chan struct{})
+	}
+	return t.idle
+}
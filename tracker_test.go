@@ -0,0 +1,72 @@
+package promise_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestTracker ensures expected behavior of promise.Tracker
+// 1. Drain returns immediately when nothing is running
+// 2. Drain waits for every tracked producer to return, even those whose Promise was settled by its Context
+// 3. the results of tracked Promises are unaffected
+func TestTracker(t *testing.T) {
+	var tr promise.Tracker
+	pending, err := tr.Drain(context.Background())
+	expect(t, 0, len(pending))
+	expect(t, nil, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	returned := make(chan struct{})
+	abandoned := promise.TrackerMe(ctx, &tr, func() (string, error) {
+		time.Sleep(20 * time.Millisecond)
+		close(returned)
+		return "abandoned", nil
+	})
+	p := promise.TrackerMe(context.Background(), &tr, func() (string, error) {
+		time.Sleep(10 * time.Millisecond)
+		return "test", nil
+	})
+
+	pending, err = tr.Drain(context.Background())
+	expect(t, 0, len(pending))
+	expect(t, nil, err)
+	select {
+	case <-returned:
+	default:
+		t.Errorf("expected Drain to wait for the abandoned producer")
+	}
+	av, ae := p()
+	expect(t, "test", av)
+	expect(t, nil, ae)
+	_, ae = abandoned()
+	expectNotCompleted(t, ctx, ae)
+}
+
+// TestTrackerDrainCancelled ensures that Tracker.Drain reports the labels of the producers still running,
+// in the order they were started, with an error wrapping ErrNotCompleted and ctx.Err() when the context is done
+func TestTrackerDrainCancelled(t *testing.T) {
+	var tr promise.Tracker
+	release := make(chan struct{})
+	defer close(release)
+	block := func() (int, error) {
+		<-release
+		return 0, nil
+	}
+	promise.TrackerMe(context.Background(), &tr, block, promise.WithLabel("first"))
+	promise.TrackerMe(context.Background(), &tr, func() (int, error) { return 0, nil }, promise.WithLabel("done"))()
+	promise.TrackerMe(context.Background(), &tr, block)
+	promise.TrackerMe(context.Background(), &tr, block, promise.WithLabel("last"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	pending, err := tr.Drain(ctx)
+	expectNotCompleted(t, ctx, err)
+	expect(t, 3, len(pending))
+	for i, label := range []string{"first", "", "last"} {
+		expect(t, label, pending[i])
+	}
+}
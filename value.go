@@ -0,0 +1,20 @@
+package promise
+
+// ValueOr blocks until p is resolved and returns its value, or fallback if p returns an error.
+func ValueOr[T any](p Promise[T], fallback T) T {
+	t, err := p()
+	if err != nil {
+		return fallback
+	}
+	return t
+}
+
+// Must blocks until p is resolved and returns its value.
+// Must panics with the error if p returns an error.
+func Must[T any](p Promise[T]) T {
+	t, err := p()
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
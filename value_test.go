@@ -0,0 +1,30 @@
+package promise_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nabowler/promise"
+)
+
+// TestValueOr ensures expected behavior of promise.ValueOr
+// 1. the value is returned when the Promise resolves with a nil error
+// 2. the fallback is returned when the Promise returns an error
+func TestValueOr(t *testing.T) {
+	expect(t, "test", promise.ValueOr(promise.Resolved("test"), "fallback"))
+	expect(t, "fallback", promise.ValueOr(promise.Rejected[string](fmt.Errorf("some error")), "fallback"))
+}
+
+// TestMust ensures expected behavior of promise.Must
+// 1. the value is returned when the Promise resolves with a nil error
+// 2. Must panics with the error when the Promise returns an error
+func TestMust(t *testing.T) {
+	expect(t, "test", promise.Must(promise.Resolved("test")))
+
+	err := fmt.Errorf("some error")
+	defer func() {
+		expect(t, err, recover())
+	}()
+	promise.Must(promise.Rejected[string](err))
+	t.Errorf("expected Must to panic")
+}
@@ -0,0 +1,48 @@
+package promise
+
+import (
+	"context"
+	"runtime"
+)
+
+type (
+	// weakHandle is kept reachable by a Promise from MeWeak,
+	// so that the Promise being collected can be detected
+	weakHandle struct {
+		// finalizers are not guaranteed to run for zero-sized allocations,
+		// nor for tiny pointer-free ones, which may be batched with longer-lived allocations
+		_ *byte
+	}
+)
+
+// MeWeak returns a Promise that will provide the result of complete, cancelling complete
+// once its result can never be observed.
+// complete is passed a Context derived from ctx, which is cancelled when ctx is done,
+// when complete returns, or when the Promise is garbage collected before its result is known,
+// so abandoned work can stop early rather than run to completion.
+// The Promise does not retain the result of a complete that returns after ctx is done.
+// If the Context is done before complete, the default value for T
+// and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func MeWeak[T any](ctx context.Context, complete func(context.Context) (T, error)) Promise[T] {
+	f := NewFuture[T](ctx)
+	producerCtx, cancel := context.WithCancel(ctx)
+
+	h := &weakHandle{}
+	runtime.SetFinalizer(h, func(*weakHandle) {
+		cancel()
+	})
+
+	// the producer must not reference h, or the Promise could never be collected
+	spawn(func() {
+		defer cancel()
+		f.Complete(complete(producerCtx))
+	})
+
+	return func() (T, error) {
+		h.keepAlive()
+		return f.Get(context.Background())
+	}
+}
+
+// keepAlive does nothing, but referencing it keeps h reachable
+func (h *weakHandle) keepAlive() {}
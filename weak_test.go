@@ -0,0 +1,71 @@
+package promise_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+// TestMeWeak ensures expected behavior of promise.MeWeak in the happy path
+func TestMeWeak(t *testing.T) {
+	for name, testcase := range testCases {
+		tc := testcase
+		t.Run(name, func(t *testing.T) {
+			p := promise.MeWeak(context.Background(), func(context.Context) (string, error) {
+				return tc.val, tc.err
+			})
+			for i := 0; i < 10; i++ {
+				av, ae := p()
+				expect(t, tc.val, av)
+				expect(t, tc.err, ae)
+			}
+		})
+	}
+}
+
+// TestMeWeakCancelled ensures that the Context passed to complete is cancelled when the parent Context is done
+// 1. complete observes the cancellation
+// 2. the default value of T and an error wrapping ErrNotCompleted and ctx.Err() are returned
+func TestMeWeakCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := promise.MeWeak(ctx, func(ctx context.Context) (string, error) {
+		<-ctx.Done()
+		return "test", nil
+	})
+	cancel()
+
+	av, ae := p()
+	expect(t, "", av)
+	expectNotCompleted(t, ctx, ae)
+}
+
+// TestMeWeakCollected ensures that the Context passed to complete is cancelled
+// when the Promise is garbage collected before complete returns
+func TestMeWeakCollected(t *testing.T) {
+	stopped := make(chan struct{})
+	createWeak(stopped)
+
+	deadline := time.After(5 * time.Second)
+	for {
+		runtime.GC()
+		select {
+		case <-stopped:
+			return
+		case <-deadline:
+			t.Fatalf("expected complete to be cancelled")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// createWeak creates a Promise from MeWeak and drops it, closing stopped once complete is cancelled
+func createWeak(stopped chan struct{}) {
+	promise.MeWeak(context.Background(), func(ctx context.Context) (string, error) {
+		<-ctx.Done()
+		close(stopped)
+		return "", ctx.Err()
+	})
+}
@@ -0,0 +1,35 @@
+package promise
+
+import "context"
+
+type (
+	// Weighted is a weighted semaphore, admitting work while the total weight acquired
+	// is within its capacity. *semaphore.Weighted from golang.org/x/sync/semaphore implements Weighted.
+	Weighted interface {
+		Acquire(ctx context.Context, n int64) error
+		Release(n int64)
+	}
+)
+
+// MeWeighted returns a Promise that will provide the result of complete, which is not started
+// until weight has been acquired from sem. The weight is released once complete returns.
+// MeWeighted will not block while acquiring.
+// ctx is passed to complete. If the Context is done before complete, including while acquiring,
+// the default value for T and an error wrapping both ErrNotCompleted and ctx.Err() will be returned.
+func MeWeighted[T any](ctx context.Context, sem Weighted, weight int64, complete func(context.Context) (T, error)) Promise[T] {
+	p, c := You[T](ctx)
+
+	spawn(func() {
+		var t T
+		if err := sem.Acquire(ctx, weight); err != nil {
+			// a semaphore.Weighted only fails once ctx is done, so the Promise is already abandoned
+			c(t, err)
+			return
+		}
+		t, err := complete(ctx)
+		sem.Release(weight)
+		c(t, err)
+	})
+
+	return p
+}
@@ -0,0 +1,103 @@
+package promise_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nabowler/promise"
+)
+
+type (
+	// testWeighted is a minimal Weighted semaphore
+	testWeighted struct {
+		mu       sync.Mutex
+		cond     *sync.Cond
+		capacity int64
+		used     int64
+		max      int64
+	}
+)
+
+func newTestWeighted(capacity int64) *testWeighted {
+	w := &testWeighted{capacity: capacity}
+	w.cond = sync.NewCond(&w.mu)
+	return w
+}
+
+func (w *testWeighted) Acquire(ctx context.Context, n int64) error {
+	stop := context.AfterFunc(ctx, func() {
+		w.mu.Lock()
+		w.cond.Broadcast()
+		w.mu.Unlock()
+	})
+	defer stop()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for w.used+n > w.capacity {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		w.cond.Wait()
+	}
+	w.used += n
+	if w.used > w.max {
+		w.max = w.used
+	}
+	return nil
+}
+
+func (w *testWeighted) Release(n int64) {
+	w.mu.Lock()
+	w.used -= n
+	w.cond.Broadcast()
+	w.mu.Unlock()
+}
+
+// TestMeWeighted ensures expected behavior of promise.MeWeighted
+// 1. the result of complete is returned
+// 2. the total weight of running producers never exceeds the capacity of the semaphore
+// 3. the weight is released once complete returns
+func TestMeWeighted(t *testing.T) {
+	sem := newTestWeighted(5)
+
+	var ps []promise.Promise[int]
+	for i := 0; i < 10; i++ {
+		ps = append(ps, promise.MeWeighted(context.Background(), sem, int64(i%3+1), func(context.Context) (int, error) {
+			time.Sleep(time.Millisecond)
+			return i, nil
+		}))
+	}
+	for i, p := range ps {
+		av, ae := p()
+		expect(t, i, av)
+		expect(t, nil, ae)
+	}
+
+	sem.mu.Lock()
+	defer sem.mu.Unlock()
+	if sem.max > sem.capacity {
+		t.Errorf("expected at most %d weight: got %d", sem.capacity, sem.max)
+	}
+	expect(t, int64(0), sem.used)
+}
+
+// TestMeWeightedCancelled ensures that promise.MeWeighted does not start complete, and returns an error
+// wrapping ErrNotCompleted and ctx.Err(), when the context is done while acquiring
+func TestMeWeightedCancelled(t *testing.T) {
+	sem := newTestWeighted(1)
+	expect(t, nil, sem.Acquire(context.Background(), 1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p := promise.MeWeighted(ctx, sem, 1, func(context.Context) (int, error) {
+		t.Errorf("complete should not be called")
+		return 1, nil
+	})
+	cancel()
+
+	av, ae := p()
+	expect(t, 0, av)
+	expectNotCompleted(t, ctx, ae)
+}